@@ -0,0 +1,170 @@
+package melli
+
+import (
+	"strings"
+
+	"github.com/ketabchi/melli/date"
+)
+
+// AddedEntry is an added entry (شناسه افزوده): a person or corporate body
+// the book can be found by besides its main entry. Name is the direct form
+// of a personal name, like "نجف دریابندری", and Inverted the form given in
+// the record, like "دریابندری، نجف". Latin and LatinInverted are the same
+// for the Latin form NLAI gives on a second line for foreign names. Born
+// and Died are the life dates, nil if not given; Born is open for living
+// people.
+type AddedEntry struct {
+	Raw           string     `json:"raw"`
+	Name          string     `json:"name"`
+	Inverted      string     `json:"inverted"`
+	Latin         string     `json:"latin,omitempty"`
+	LatinInverted string     `json:"latin_inverted,omitempty"`
+	Corporate     bool       `json:"corporate,omitempty"`
+	Born          *date.Year `json:"born,omitempty"`
+	Died          *date.Year `json:"died,omitempty"`
+	Roles         []Role     `json:"roles"`
+}
+
+// AddedEntries returns the added entries of the book in record order.
+func (b *Book) AddedEntries() []AddedEntry {
+	entries := make([]AddedEntry, 0)
+	for _, text := range b.fields.Values(LabelAddedEntry) {
+		if e := b.addedEntryFromField(text); e.Name != "" {
+			entries = append(entries, e)
+		}
+	}
+
+	return entries
+}
+
+// heading is a name heading as used by the main entry and the added
+// entries, like "دریابندری، نجف، ۱۳۰۸ - ۱۳۹۹.، مترجم" with the Latin form
+// of foreign names on a second line.
+type heading struct {
+	family, given           string
+	latinFamily, latinGiven string
+	born, died              *date.Year
+	roles                   []Role
+	corporate               bool
+}
+
+// parseHeading reads a name heading. A name with neither a given name nor
+// life dates, like "دانشگاه هاروارد", is taken as corporate, as is one with
+// subdivisions, like "ایران. مجلس شورای اسلامی. کتابخانه، موزه و مرکز
+// اسناد".
+func parseHeading(text string) heading {
+	lines := strings.Split(clean(text), "\n")
+	h := heading{roles: []Role{}}
+
+	parts := strings.Split(strings.ReplaceAll(lines[0], "٬", "،"), "،")
+	h.family = strings.Trim(parts[0], " .")
+	if strings.Contains(h.family, ". ") {
+		return parseCorporateHeading(parts, lines[1:])
+	}
+	dates := ""
+	for i, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		switch role, rest, ok := matchRole(strings.Trim(part, ".")); {
+		case ok && rest == "":
+			h.roles = append(h.roles, role)
+		case reNumber.MatchString(part):
+			dates = part
+		case i == 0:
+			h.given = part
+		}
+	}
+	h.born, h.died = lifeDates(dates)
+	h.corporate = h.given == "" && dates == ""
+
+	if len(lines) > 1 {
+		latin := strings.Split(strings.Trim(clean(lines[1]), " "), ",")
+		h.latinFamily = strings.Trim(latin[0], " .")
+		if len(latin) > 1 && !reNumber.MatchString(latin[1]) {
+			h.latinGiven = strings.TrimSpace(latin[1])
+		}
+	}
+
+	return h
+}
+
+// parseCorporateHeading reads the parts of a corporate heading with
+// subdivisions, whose commas are part of the name rather than separating a
+// given name from it.
+func parseCorporateHeading(parts, latin []string) heading {
+	h := heading{roles: []Role{}, corporate: true}
+
+	names := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if role, rest, ok := matchRole(strings.Trim(part, ".")); ok && rest == "" {
+			h.roles = append(h.roles, role)
+			continue
+		}
+		names = append(names, part)
+	}
+	h.family = strings.Trim(strings.Join(names, "، "), " .")
+	if len(latin) > 0 {
+		h.latinFamily = strings.Trim(clean(latin[0]), " .")
+	}
+
+	return h
+}
+
+// direct returns the name in direct order, given name first.
+func (h heading) direct() string {
+	return strings.TrimSpace(h.given + " " + h.family)
+}
+
+// inverted returns the name as given in the record, family name first.
+func (h heading) inverted() string {
+	if h.given == "" {
+		return h.family
+	}
+
+	return h.family + "، " + h.given
+}
+
+func (h heading) latinDirect() string {
+	return strings.TrimSpace(h.latinGiven + " " + h.latinFamily)
+}
+
+func (h heading) latinInverted() string {
+	if h.latinGiven == "" {
+		return h.latinFamily
+	}
+
+	return h.latinFamily + ", " + h.latinGiven
+}
+
+func (b *Book) addedEntryFromField(text string) AddedEntry {
+	h := parseHeading(text)
+
+	return AddedEntry{
+		Raw:           clean(text),
+		Name:          h.direct(),
+		Inverted:      h.inverted(),
+		Latin:         h.latinDirect(),
+		LatinInverted: h.latinInverted(),
+		Corporate:     h.corporate,
+		Born:          h.born,
+		Died:          h.died,
+		Roles:         h.roles,
+	}
+}
+
+// lifeDates reads dates like "۱۳۳۰-", "۱۳۰۸ - ۱۳۹۹." or "۷۲۷-۷۹۲ق.". A
+// missing date is nil, as the birth of "-۷۹۲ق." is.
+func lifeDates(text string) (born, died *date.Year) {
+	years := date.ParseYears(text)
+	if strings.HasPrefix(strings.TrimLeft(text, "[]؟? "), "-") && len(years) == 1 {
+		return nil, &years[0]
+	}
+	if len(years) > 0 {
+		born = &years[0]
+	}
+	if len(years) > 1 {
+		died = &years[1]
+	}
+
+	return
+}
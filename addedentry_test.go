@@ -0,0 +1,118 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ketabchi/melli/date"
+)
+
+func TestAddedEntryFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  AddedEntry
+	}{
+		{
+			"‏دریابندری، نجف، ۱۳۰۸ - ۱۳۹۹.، مترجم",
+			AddedEntry{
+				Name:     "نجف دریابندری",
+				Inverted: "دریابندری، نجف",
+				Born:     &date.Year{Value: 1308, Calendar: date.Jalali},
+				Died:     &date.Year{Value: 1399, Calendar: date.Jalali},
+				Roles:    []Role{RoleTranslator},
+			},
+		},
+		{
+			"‏پارسا، مهدی، ۱۳۶۰-، گردآورنده، مترجم",
+			AddedEntry{
+				Name:     "مهدی پارسا",
+				Inverted: "پارسا، مهدی",
+				Born:     &date.Year{Value: 1360, Calendar: date.Jalali, Open: true},
+				Roles:    []Role{RoleCompiler, RoleTranslator},
+			},
+		},
+		{
+			"‏هاوکینگ، استیون، ۱۹۴۲ - ۲۰۱۸م.\n‏Hawking, Stephen",
+			AddedEntry{
+				Name:          "استیون هاوکینگ",
+				Inverted:      "هاوکینگ، استیون",
+				Latin:         "Stephen Hawking",
+				LatinInverted: "Hawking, Stephen",
+				Born:          &date.Year{Value: 1942, Calendar: date.Gregorian},
+				Died:          &date.Year{Value: 2018, Calendar: date.Gregorian},
+				Roles:         []Role{},
+			},
+		},
+		{
+			"‏حافظ، شمس‌الدین محمد، ۷۲۷-۷۹۲ق.",
+			AddedEntry{
+				Name:     "شمس‌الدین محمد حافظ",
+				Inverted: "حافظ، شمس‌الدین محمد",
+				Born:     &date.Year{Value: 727, Calendar: date.Hijri},
+				Died:     &date.Year{Value: 792, Calendar: date.Hijri},
+				Roles:    []Role{},
+			},
+		},
+		{
+			"‏حافظ، شمس‌الدین محمد، -۷۹۲ق.",
+			AddedEntry{
+				Name:     "شمس‌الدین محمد حافظ",
+				Inverted: "حافظ، شمس‌الدین محمد",
+				Died:     &date.Year{Value: 792, Calendar: date.Hijri},
+				Roles:    []Role{},
+			},
+		},
+		{
+			"‏دانشگاه هاروارد",
+			AddedEntry{Name: "دانشگاه هاروارد", Inverted: "دانشگاه هاروارد", Corporate: true, Roles: []Role{}},
+		},
+		{
+			"‏ایران. مجلس شورای اسلامی. کتابخانه، موزه و مرکز اسناد",
+			AddedEntry{
+				Name:      "ایران. مجلس شورای اسلامی. کتابخانه، موزه و مرکز اسناد",
+				Inverted:  "ایران. مجلس شورای اسلامی. کتابخانه، موزه و مرکز اسناد",
+				Corporate: true,
+				Roles:     []Role{},
+			},
+		},
+		{
+			"‏ایران. وزارت فرهنگ و ارشاد اسلامی. اداره کل امور فرهنگی، گردآورنده\n‏Iran. Vizarat-i Farhang va Irshad-i Islami",
+			AddedEntry{
+				Name:          "ایران. وزارت فرهنگ و ارشاد اسلامی. اداره کل امور فرهنگی",
+				Inverted:      "ایران. وزارت فرهنگ و ارشاد اسلامی. اداره کل امور فرهنگی",
+				Latin:         "Iran. Vizarat-i Farhang va Irshad-i Islami",
+				LatinInverted: "Iran. Vizarat-i Farhang va Irshad-i Islami",
+				Corporate:     true,
+				Roles:         []Role{RoleCompiler},
+			},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		e := b.addedEntryFromField(test.text)
+		e.Raw = ""
+		if !reflect.DeepEqual(e, test.exp) {
+			t.Errorf("Test %d: Expected added entry %+v, but got %+v", i, test.exp, e)
+		}
+	}
+}
+
+func TestTranslatorsFromAddedEntries(t *testing.T) {
+	html := `<table>
+<tr><td>` + "‏" + `عنوان و نام پديدآور</td><td>:</td><td>` + "‏" + `دیوان/ [از] شاعری ناشناس؛ برگردان به نثر از مریم کریمی.</td></tr>
+<tr><td>` + "‏" + `شناسه افزوده</td><td>:</td><td>` + "‏" + `کریمی، مریم، ۱۳۵۰-، مترجم</td></tr>
+</table>`
+
+	book, err := ParseBookHTML(html, "")
+	if err != nil {
+		t.Fatalf("Error on parsing book html: %s", err)
+	}
+	if translators := book.Translators(); !reflect.DeepEqual(translators, []string{"مریم کریمی"}) {
+		t.Errorf("Expected translators [مریم کریمی], but got %q", translators)
+	}
+	exp := []Contributor{{"شاعری ناشناس", RoleAuthor}, {"مریم کریمی", RoleTranslator}}
+	if contributors := book.Contributors(); !reflect.DeepEqual(contributors, exp) {
+		t.Errorf("Expected contributors %v, but got %v", exp, contributors)
+	}
+}
@@ -0,0 +1,77 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+)
+
+// AgeGroup is one of the reader age groups (گروه سنی) of children's books,
+// named by a letter from "الف" for preschoolers to "ه" for high school
+// students, with the ages it stands for.
+type AgeGroup struct {
+	Group  string `json:"group"`
+	MinAge int    `json:"min_age"`
+	MaxAge int    `json:"max_age"`
+}
+
+// ageGroups are the age groups in order, each spanning a stage of school.
+var ageGroups = []AgeGroup{
+	{"الف", 0, 6},
+	{"ب", 7, 9},
+	{"ج", 10, 11},
+	{"د", 12, 14},
+	{"ه", 15, 18},
+}
+
+var reAgeGroupRange = regexp.MustCompile(`^(\S+)\s*(?:-|تا)\s*(\S+)$`)
+
+// AgeGroups returns the age groups of the "گروه سنی" note, like "الف، ب".
+// A range like "ب - د" gives every group in it.
+func (b *Book) AgeGroups() []AgeGroup {
+	return ageGroupsFromText(b.AgeGroupNote())
+}
+
+func ageGroupsFromText(text string) []AgeGroup {
+	groups := make([]AgeGroup, 0)
+
+	text = strings.NewReplacer(",", "،", " و ", "،", "هـ", "ه").Replace(text)
+	for _, part := range strings.Split(text, "،") {
+		part = strings.Trim(part, " .")
+		from, to := part, part
+		if ss := reAgeGroupRange.FindStringSubmatch(part); ss != nil {
+			from, to = ss[1], ss[2]
+		}
+
+		i, j := ageGroupIndex(from), ageGroupIndex(to)
+		if i < 0 || j < i {
+			continue
+		}
+		for _, g := range ageGroups[i : j+1] {
+			if !hasAgeGroup(groups, g) {
+				groups = append(groups, g)
+			}
+		}
+	}
+
+	return groups
+}
+
+func ageGroupIndex(letter string) int {
+	for i, g := range ageGroups {
+		if g.Group == letter {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func hasAgeGroup(groups []AgeGroup, g AgeGroup) bool {
+	for _, other := range groups {
+		if other == g {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAgeGroupsFromText(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  []string
+	}{
+		{"الف", []string{"الف"}},
+		{"الف، ب", []string{"الف", "ب"}},
+		{"د، ه", []string{"د", "ه"}},
+		{"د، هـ", []string{"د", "ه"}},
+		{"ب و ج", []string{"ب", "ج"}},
+		{"ب - د", []string{"ب", "ج", "د"}},
+		{"الف تا ج", []string{"الف", "ب", "ج"}},
+		{"ب، ب", []string{"ب"}},
+		{"بزرگسال", []string{}},
+		{"", []string{}},
+	}
+
+	for i, test := range tests {
+		groups := make([]string, 0)
+		for _, g := range ageGroupsFromText(test.text) {
+			groups = append(groups, g.Group)
+		}
+		if !reflect.DeepEqual(groups, test.exp) {
+			t.Errorf("Test %d: Expected age groups %q, but got %q", i, test.exp, groups)
+		}
+	}
+}
+
+func TestAgeGroups(t *testing.T) {
+	tests := []struct {
+		url string
+		exp []AgeGroup
+	}{
+		{"http://opac.nlai.ir/opac-prod/bibliographic/2345835", []AgeGroup{{"ب", 7, 9}, {"ج", 10, 11}}},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/1126271", []AgeGroup{{"ب", 7, 9}}},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/636958", []AgeGroup{}},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if groups := book.AgeGroups(); !reflect.DeepEqual(groups, test.exp) {
+			t.Errorf("Test %d: Expected age groups %v, but got %v", i, test.exp, groups)
+		}
+	}
+}
@@ -1,60 +1,159 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-	"net/url"
 	"strings"
+	"time"
 
 	"github.com/PuerkitoBio/goquery"
 	"github.com/antzucaro/matchr"
+	"github.com/ketabchi/melli/isbn"
 	"github.com/ketabchi/util"
 )
 
-func GetBookURLByISBN(isbn string, args ...string) (string, error) {
-	searchURL := fmt.Sprintf("http://opac.nlai.ir/opac-prod/search/bibliographicSimpleSearchProcess.do?simpleSearch.value=%s&bibliographicLimitQueryBuilder.biblioDocType=BF&simpleSearch.indexFieldId=221091&command=I&simpleSearch.tokenized=true&classType=0", isbn)
-	res, err := http.Get(searchURL)
-	if err != nil {
-		return "", err
+// DefaultBaseURL is the root of the NLAI OPAC that every request is made
+// against unless WithBaseURL says otherwise.
+const DefaultBaseURL = "http://opac.nlai.ir/opac-prod"
+
+// DefaultClient is used by the package level functions.
+var DefaultClient = NewClient()
+
+// Client fetches pages from the NLAI OPAC.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+	timeout    time.Duration
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithHTTPClient sets the http.Client used for every request. A nil client
+// is taken as http.DefaultClient.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithBaseURL points the client at another OPAC root, e.g. a local test
+// server.
+func WithBaseURL(u string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(u, "/")
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return func(c *Client) {
+		c.userAgent = ua
+	}
+}
+
+// WithTimeout limits the time spent on a single request, whatever the order
+// of the options. It's set on a copy of the http.Client so a client passed
+// to WithHTTPClient isn't modified.
+func WithTimeout(d time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = d
+	}
+}
+
+// NewClient returns a Client configured by opts.
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		httpClient: http.DefaultClient,
+		baseURL:    DefaultBaseURL,
+	}
+	for _, opt := range opts {
+		opt(c)
 	}
+	if c.httpClient == nil {
+		c.httpClient = http.DefaultClient
+	}
+	if c.timeout > 0 {
+		hc := *c.httpClient
+		hc.Timeout = c.timeout
+		c.httpClient = &hc
+	}
+
+	return c
+}
+
+// BaseURL returns the OPAC root the client sends requests to.
+func (c *Client) BaseURL() string {
+	return c.baseURL
+}
+
+// BookURL returns the url of the bibliographic record page with the given
+// NLAI record id.
+func (c *Client) BookURL(id string) string {
+	return fmt.Sprintf("%s/bibliographic/%s", c.baseURL, id)
+}
 
-	doc, err := goquery.NewDocumentFromResponse(res)
+// Get fetches u and parses the response body as HTML.
+func (c *Client) Get(ctx context.Context, u string) (*goquery.Document, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
 	}
 
-	link, exists := doc.Find("#td2 > a").Attr("href")
-	if len(args) > 0 {
-		score := 0.0
-		exists = false
-		arg := util.Clean(args[0])
-		doc.Find("#td2 > a").Each(func(i int, sel *goquery.Selection) {
-			title := util.Clean(sel.Text())
-			tmp := matchr.SmithWaterman(arg, title)
-			tmp /= float64(len([]rune(arg)))
-			if tmp > score && (tmp > 0.2 || strings.Contains(arg, title)) {
-				link, exists = sel.Attr("href")
-				score = tmp
-			}
-		})
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
 	}
+	defer res.Body.Close()
 
-	if !exists {
-		return "", nil
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %q fetching %s", res.Status, u)
 	}
 
-	u, err := url.Parse(link)
+	return goquery.NewDocumentFromReader(res.Body)
+}
+
+// GetBookURLByISBN calls DefaultClient.GetBookURLByISBN with a background
+// context.
+func GetBookURLByISBN(number string, args ...string) (string, error) {
+	return DefaultClient.GetBookURLByISBN(context.Background(), number, args...)
+}
+
+// GetBookURLByISBN searches the OPAC for the ISBN number and returns the url
+// of the first bibliographic record found, or an empty string if there is
+// none. number may have Persian digits and hyphens and may be an ISBN-10;
+// an error wrapping isbn.ErrInvalidISBN is returned if it isn't valid. If a
+// title is given as the first of args, the record whose title matches it
+// best is returned instead.
+func (c *Client) GetBookURLByISBN(ctx context.Context, number string, args ...string) (string, error) {
+	isbn13, err := isbn.ToISBN13(number)
 	if err != nil {
 		return "", err
 	}
-	params, err := url.ParseQuery(u.RawQuery)
-	if err != nil {
+
+	results, err := c.Search(ctx, NewQuery(IndexISBN, isbn13))
+	if err != nil || len(results) == 0 {
 		return "", err
 	}
 
-	if id, exists := params["id"]; !exists {
-		return "", fmt.Errorf("can't find book id in search page book link %s", link)
-	} else {
-		return fmt.Sprintf("http://opac.nlai.ir/opac-prod/bibliographic/%s", id[0]), nil
+	if len(args) == 0 {
+		return results[0].URL, nil
 	}
+
+	link, score := "", 0.0
+	arg := util.Clean(args[0])
+	for _, r := range results {
+		tmp := matchr.SmithWaterman(arg, r.Title)
+		tmp /= float64(len([]rune(arg)))
+		if tmp > score && (tmp > 0.2 || strings.Contains(arg, r.Title)) {
+			link, score = r.URL, tmp
+		}
+	}
+
+	return link, nil
 }
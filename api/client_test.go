@@ -1,6 +1,82 @@
 package api
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ketabchi/melli/isbn"
+)
+
+// Search fixtures are saved search result pages under testdata/search, named
+// by the searched value, or the value of the first term of an advanced
+// search. Run the tests with -record to re-download them. The pages checked
+// in so far were written by hand after NLAI pages and should be replaced by
+// recorded ones.
+var record = flag.Bool("record", false, "re-download fixture pages from the NLAI OPAC")
+
+func fixtureServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		value := r.URL.Query().Get("simpleSearch.value")
+		if value == "" {
+			value = r.URL.Query().Get("advancedSearch.simpleSearch[0].value")
+		}
+		html, err := ioutil.ReadFile(filepath.Join("testdata", "search", value+".html"))
+		if err != nil {
+			t.Errorf("No search fixture for %q: %s", value, err)
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=UTF-8")
+		w.Write(html)
+	}))
+}
+
+func recordSearchFixture(number string) error {
+	isbn13, err := isbn.ToISBN13(number)
+	if err != nil {
+		return err
+	}
+
+	return recordSearch(NewQuery(IndexISBN, isbn13))
+}
+
+// recordSearch saves the first result page of q as received, named by the
+// value of its first term.
+func recordSearch(q Query) error {
+	u, err := DefaultClient.searchURL(q)
+	if err != nil {
+		return err
+	}
+	res, err := DefaultClient.httpClient.Get(u)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q fetching %s", res.Status, u)
+	}
+
+	f, err := os.Create(filepath.Join("testdata", "search", q.Terms[0].Value+".html"))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, res.Body); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
 
 func TestGetBookURLByISBN(t *testing.T) {
 	tests := []struct {
@@ -11,22 +87,22 @@ func TestGetBookURLByISBN(t *testing.T) {
 		{
 			"9789646235793",
 			[]string{},
-			"http://opac.nlai.ir/opac-prod/bibliographic/5800683",
+			"5800683",
 		},
 		{
 			"9786008237631",
 			[]string{},
-			"http://opac.nlai.ir/opac-prod/bibliographic/5134460",
+			"5134460",
 		},
 		{
 			"9786006860152",
 			[]string{"کودک باهوش(4سالگی)مهارت‌نوشتن(کتاب‌‌پرنده) #"},
-			"http://opac.nlai.ir/opac-prod/bibliographic/4634555",
+			"4634555",
 		},
 		{
 			"9789642616763",
 			[]string{"ویتامین‎های موفقیت(نقش‎نگین)"},
-			"http://opac.nlai.ir/opac-prod/bibliographic/2055747",
+			"2055747",
 		},
 		{
 			"9786009639267",
@@ -38,17 +114,109 @@ func TestGetBookURLByISBN(t *testing.T) {
 			[]string{"این من هستم(4ج،همراه‌کیف)لوپه‌تو #"},
 			"",
 		},
+		{
+			"۹۷۸-۶۰۰-۸۲۳۷-۶۳-۱",
+			[]string{},
+			"5134460",
+		},
+		{
+			"964-6235-79-4",
+			[]string{},
+			"5800683",
+		},
 	}
 
+	ts := fixtureServer(t)
+	defer ts.Close()
+	c := NewClient(WithBaseURL(ts.URL))
+
 	for i, test := range tests {
-		url, err := GetBookURLByISBN(test.isbn, test.title...)
+		if *record {
+			if err := recordSearchFixture(test.isbn); err != nil {
+				t.Errorf("Test %d: Error on recording search fixture of %s isbn: %s",
+					i, test.isbn, err)
+			}
+		}
+
+		exp := ""
+		if test.exp != "" {
+			exp = ts.URL + "/bibliographic/" + test.exp
+		}
+		url, err := c.GetBookURLByISBN(context.Background(), test.isbn, test.title...)
 		if err != nil {
 			t.Errorf("Test %d: Error on getting book url by %s isbn: %s",
 				i, test.isbn, err)
 		}
-		if url != test.exp {
+		if url != exp {
 			t.Errorf("Test %d: Expected %s but got %s for %s isbn.",
-				i, test.exp, url, test.isbn)
+				i, exp, url, test.isbn)
 		}
 	}
 }
+
+func TestGetBookURLByInvalidISBN(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request for invalid isbn: %s", r.URL)
+	}))
+	defer ts.Close()
+	c := NewClient(WithBaseURL(ts.URL))
+
+	for i, s := range []string{"9789646235794", "978964623579", "not an isbn"} {
+		if _, err := c.GetBookURLByISBN(context.Background(), s); !errors.Is(err, isbn.ErrInvalidISBN) {
+			t.Errorf("Test %d: Expected invalid isbn error for %q, but got %v", i, s, err)
+		}
+	}
+}
+
+func TestClientOptions(t *testing.T) {
+	var ua, query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ua = r.UserAgent()
+		query = r.URL.Query().Get("simpleSearch.value")
+		fmt.Fprint(w, `<table><tr><td id="td2"><a href="/opac-prod/search/briefListSearch.do?command=FULL_VIEW&id=5800683">title</a></td></tr></table>`)
+	}))
+	defer ts.Close()
+
+	c := NewClient(WithBaseURL(ts.URL+"/"), WithUserAgent("melli-test"), WithTimeout(time.Second))
+	url, err := c.GetBookURLByISBN(context.Background(), "9789646235793")
+	if err != nil {
+		t.Fatalf("Error on getting book url from test server: %s", err)
+	}
+	if exp := ts.URL + "/bibliographic/5800683"; url != exp {
+		t.Errorf("Expected %s but got %s", exp, url)
+	}
+	if ua != "melli-test" {
+		t.Errorf("Expected user agent 'melli-test', but got '%s'", ua)
+	}
+	if query != "9789646235793" {
+		t.Errorf("Expected searched isbn '9789646235793', but got '%s'", query)
+	}
+
+	for i, c := range []*Client{
+		NewClient(WithBaseURL(ts.URL), WithHTTPClient(nil)),
+		NewClient(WithBaseURL(ts.URL), WithHTTPClient(nil), WithTimeout(time.Second)),
+	} {
+		if _, err := c.GetBookURLByISBN(context.Background(), "9789646235793"); err != nil {
+			t.Errorf("Test %d: Error on getting book url with nil http client: %s", i, err)
+		}
+	}
+
+	hc := &http.Client{}
+	for i, c := range []*Client{
+		NewClient(WithHTTPClient(hc), WithTimeout(time.Second)),
+		NewClient(WithTimeout(time.Second), WithHTTPClient(hc)),
+	} {
+		if c.httpClient.Timeout != time.Second {
+			t.Errorf("Test %d: Expected timeout %s, but got %s", i, time.Second, c.httpClient.Timeout)
+		}
+	}
+	if hc.Timeout != 0 {
+		t.Errorf("Expected the given http client to be left unmodified, but its timeout is %s", hc.Timeout)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := c.GetBookURLByISBN(ctx, "9789646235793"); err == nil {
+		t.Error("Expected error on canceled context, but got nil")
+	}
+}
@@ -0,0 +1,356 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http/cookiejar"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/ketabchi/melli/internal/persian"
+	"github.com/ketabchi/util"
+)
+
+// ErrEmptyQuery is returned when searching with a query that has no terms.
+var ErrEmptyQuery = errors.New("api: empty search query")
+
+// Index is a field the OPAC searches, as the indexFieldId of its search
+// form.
+type Index string
+
+// IndexISBN is the index of the OPAC's ISBN search. Other indexes can be
+// searched by the indexFieldId the OPAC search form gives them.
+//
+// TODO: add the title, author, publisher, subject and year indexes once
+// their ids are taken from the search form and a result page of each is
+// recorded under testdata/search.
+const IndexISBN Index = "221091"
+
+// Operator joins a search term to the terms before it.
+type Operator string
+
+const (
+	And Operator = "and"
+	Or  Operator = "or"
+	Not Operator = "not"
+)
+
+// DocType limits a search to one type of document.
+type DocType string
+
+const (
+	DocTypeAll  DocType = ""
+	DocTypeBook DocType = "BF"
+)
+
+// Term is a value searched for in an index. Operator joins it to the terms
+// before it and is ignored for the first term; an empty Operator is And.
+type Term struct {
+	Index    Index
+	Value    string
+	Operator Operator
+}
+
+// Query is a search of the OPAC. A query with more than one term is sent
+// to the advanced search.
+type Query struct {
+	Terms   []Term
+	DocType DocType
+}
+
+// NewQuery returns a query for books with value in index.
+func NewQuery(index Index, value string) Query {
+	return Query{Terms: []Term{{Index: index, Value: value}}, DocType: DocTypeBook}
+}
+
+// And returns q also requiring value in index.
+func (q Query) And(index Index, value string) Query {
+	return q.with(Term{Index: index, Value: value, Operator: And})
+}
+
+// Or returns q also matching records with value in index.
+func (q Query) Or(index Index, value string) Query {
+	return q.with(Term{Index: index, Value: value, Operator: Or})
+}
+
+// Not returns q excluding records with value in index.
+func (q Query) Not(index Index, value string) Query {
+	return q.with(Term{Index: index, Value: value, Operator: Not})
+}
+
+func (q Query) with(t Term) Query {
+	q.Terms = append(append([]Term(nil), q.Terms...), t)
+	return q
+}
+
+// SearchResult is a record listed on a search result page. Year is zero if
+// the listed year isn't a number.
+type SearchResult struct {
+	ID        string `json:"id"`
+	URL       string `json:"url"`
+	Title     string `json:"title"`
+	Author    string `json:"author"`
+	Publisher string `json:"publisher"`
+	Year      int    `json:"year"`
+}
+
+// Search calls DefaultClient.Search.
+func Search(ctx context.Context, q Query) ([]SearchResult, error) {
+	return DefaultClient.Search(ctx, q)
+}
+
+// Search returns the records on the first page of results of q. Use
+// SearchIterator for all of them.
+func (c *Client) Search(ctx context.Context, q Query) ([]SearchResult, error) {
+	u, err := c.searchURL(q)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := c.Get(ctx, u)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.searchResults(doc)
+}
+
+func (c *Client) searchURL(q Query) (string, error) {
+	if len(q.Terms) == 0 {
+		return "", ErrEmptyQuery
+	}
+
+	params := url.Values{}
+	params.Set("bibliographicLimitQueryBuilder.biblioDocType", string(q.DocType))
+	params.Set("command", "I")
+	params.Set("classType", "0")
+
+	if len(q.Terms) == 1 {
+		params.Set("simpleSearch.value", q.Terms[0].Value)
+		params.Set("simpleSearch.indexFieldId", string(q.Terms[0].Index))
+		params.Set("simpleSearch.tokenized", "true")
+
+		return c.baseURL + "/search/bibliographicSimpleSearchProcess.do?" + params.Encode(), nil
+	}
+
+	// TODO: the advanced search path and field names have not been checked
+	// against the OPAC search form; record an advanced search page once
+	// they are.
+	for i, t := range q.Terms {
+		prefix := "advancedSearch.simpleSearch[" + strconv.Itoa(i) + "]."
+		params.Set(prefix+"value", t.Value)
+		params.Set(prefix+"indexFieldId", string(t.Index))
+		params.Set(prefix+"tokenized", "true")
+		if i > 0 {
+			op := t.Operator
+			if op == "" {
+				op = And
+			}
+			params.Set(prefix+"operator", string(op))
+		}
+	}
+
+	return c.baseURL + "/search/bibliographicAdvancedSearchProcess.do?" + params.Encode(), nil
+}
+
+// searchResults reads the rows of a search result page, whose cells are
+// #td2 to #td5 for the title, author, publisher and year.
+func (c *Client) searchResults(doc *goquery.Document) ([]SearchResult, error) {
+	results := make([]SearchResult, 0)
+
+	var err error
+	doc.Find("#td2").EachWithBreak(func(i int, td *goquery.Selection) bool {
+		a := td.Find("a")
+		link, _ := a.Attr("href")
+
+		var id string
+		if id, err = bookID(link); err != nil {
+			return false
+		}
+
+		row := td.Parent()
+		year, _ := persian.Atoi(strings.Trim(util.Clean(row.Find("#td5").Text()), "[]?؟ "))
+		results = append(results, SearchResult{
+			ID:        id,
+			URL:       c.BookURL(id),
+			Title:     util.Clean(a.Text()),
+			Author:    util.Clean(row.Find("#td3").Text()),
+			Publisher: util.Clean(row.Find("#td4").Text()),
+			Year:      year,
+		})
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// bookID returns the record id of a search result link.
+func bookID(link string) (string, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return "", err
+	}
+	params, err := url.ParseQuery(u.RawQuery)
+	if err != nil {
+		return "", err
+	}
+
+	id := params.Get("id")
+	if id == "" {
+		return "", fmt.Errorf("can't find book id in search page book link %s", link)
+	}
+
+	return id, nil
+}
+
+// TODO: the "بعدی" link, its pageNumber parameter, the "تعداد نتایج:" total
+// in .listheader and the session cookie paging relies on are taken from
+// hand-written pages; check them against a recorded multi-page result set.
+var reSearchTotal = regexp.MustCompile(`تعداد نتایج\s*:\s*([0-9۰-۹]+)`)
+
+// Iterator steps through every result of a search, fetching the result
+// pages as they are needed:
+//
+//	it := c.SearchIterator(ctx, q, 100)
+//	for it.Next() {
+//		r := it.Result()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type Iterator struct {
+	c     *Client
+	ctx   context.Context
+	url   string
+	max   int
+	n     int
+	total int
+	page  []SearchResult
+	ids   []string
+	cur   SearchResult
+	err   error
+}
+
+// SearchIterator calls DefaultClient.SearchIterator.
+func SearchIterator(ctx context.Context, q Query, max int) *Iterator {
+	return DefaultClient.SearchIterator(ctx, q, max)
+}
+
+// SearchIterator returns an iterator over the results of q, following the
+// "بعدی" link of each result page to the next. It stops after max results,
+// or at the last page if max isn't positive.
+//
+// The OPAC is expected to page through the results of the search made in
+// the same session, so the iterator keeps the session cookie: its requests
+// use a copy of the client's http.Client with a cookie jar of its own,
+// unless the client already has one. It also stops if a page lists the same
+// records as the one before, as a lost session would make the OPAC do.
+func (c *Client) SearchIterator(ctx context.Context, q Query, max int) *Iterator {
+	session := *c
+	if c.httpClient.Jar == nil {
+		hc := *c.httpClient
+		hc.Jar, _ = cookiejar.New(nil)
+		session.httpClient = &hc
+	}
+
+	it := &Iterator{c: &session, ctx: ctx, max: max}
+	it.url, it.err = c.searchURL(q)
+
+	return it
+}
+
+// Next advances to the next result, fetching the next page if needed. It
+// returns false when there are no more results or on an error.
+func (it *Iterator) Next() bool {
+	if it.err != nil || (it.max > 0 && it.n >= it.max) {
+		return false
+	}
+
+	for len(it.page) == 0 {
+		if it.url == "" || !it.fetch() {
+			return false
+		}
+	}
+	it.cur, it.page = it.page[0], it.page[1:]
+	it.n++
+
+	return true
+}
+
+func (it *Iterator) fetch() bool {
+	u := it.url
+	doc, err := it.c.Get(it.ctx, u)
+	if err != nil {
+		it.err = err
+		return false
+	}
+	prev := it.ids
+	if it.page, it.err = it.c.searchResults(doc); it.err != nil {
+		return false
+	}
+	it.ids = make([]string, len(it.page))
+	for i, r := range it.page {
+		it.ids[i] = r.ID
+	}
+	if len(prev) > 0 && reflect.DeepEqual(prev, it.ids) {
+		it.page, it.url = nil, ""
+		return false
+	}
+
+	if ss := reSearchTotal.FindStringSubmatch(util.Clean(doc.Find(".listheader").Text())); ss != nil {
+		it.total, _ = persian.Atoi(ss[1])
+	}
+	if it.url = nextPageURL(doc, u); it.url == u {
+		it.url = ""
+	}
+
+	return true
+}
+
+// Result returns the result Next advanced to.
+func (it *Iterator) Result() SearchResult {
+	return it.cur
+}
+
+// Total returns the number of results the OPAC reports for the search,
+// which is known once Next has been called, or zero if it isn't given.
+func (it *Iterator) Total() int {
+	return it.total
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// nextPageURL returns the url of the "بعدی" link of a result page at base,
+// or an empty string on the last page.
+func nextPageURL(doc *goquery.Document, base string) string {
+	link, exists := doc.Find("a").FilterFunction(func(i int, a *goquery.Selection) bool {
+		return strings.Contains(a.Text(), "بعدی")
+	}).First().Attr("href")
+	if !exists {
+		return ""
+	}
+
+	b, err := url.Parse(base)
+	if err != nil {
+		return ""
+	}
+	u, err := b.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return u.String()
+}
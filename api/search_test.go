@@ -0,0 +1,270 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Indexes of the test queries. The test servers don't read them, so they
+// needn't be the ids the OPAC uses.
+const (
+	testIndexTitle     Index = "title"
+	testIndexAuthor    Index = "author"
+	testIndexPublisher Index = "publisher"
+	testIndexSubject   Index = "subject"
+	testIndexYear      Index = "year"
+)
+
+// TestSearch runs searches against the fixture server. Until the fixtures
+// are recorded with -record, it only checks that result pages are read, not
+// that the OPAC understands the queries sent.
+func TestSearch(t *testing.T) {
+	tests := []struct {
+		query Query
+		exp   []SearchResult
+	}{
+		{
+			NewQuery(testIndexTitle, "سمفونی مردگان"),
+			[]SearchResult{
+				{"636958", "/bibliographic/636958", "سمفونی مردگان", "معروفی، عباس", "ققنوس", 1397},
+				{"4291150", "/bibliographic/4291150", "سمفونی مردگان", "معروفی، عباس", "ققنوس", 1395},
+				{"1845103", "/bibliographic/1845103", "بررسی رمان سمفونی مردگان", "رضایی، علی", "نشر نو", 1388},
+			},
+		},
+		{
+			NewQuery(testIndexAuthor, "معروفی").And(testIndexPublisher, "ققنوس"),
+			[]SearchResult{
+				{"636958", "/bibliographic/636958", "سمفونی مردگان", "معروفی، عباس", "ققنوس", 1397},
+				{"3216714", "/bibliographic/3216714", "سال بلوا", "معروفی، عباس", "ققنوس", 1396},
+			},
+		},
+	}
+
+	ts := fixtureServer(t)
+	defer ts.Close()
+	c := NewClient(WithBaseURL(ts.URL))
+
+	for i, test := range tests {
+		if *record {
+			if err := recordSearch(test.query); err != nil {
+				t.Errorf("Test %d: Error on recording search fixture of %v: %s", i, test.query, err)
+			}
+		}
+
+		results, err := c.Search(context.Background(), test.query)
+		if err != nil {
+			t.Errorf("Test %d: Error on searching %v: %s", i, test.query, err)
+			continue
+		}
+		if len(results) != len(test.exp) {
+			t.Errorf("Test %d: Expected %d results, but got %d", i, len(test.exp), len(results))
+			continue
+		}
+		for j, exp := range test.exp {
+			exp.URL = ts.URL + exp.URL
+			if !reflect.DeepEqual(results[j], exp) {
+				t.Errorf("Test %d: Expected result %d %+v, but got %+v", i, j, exp, results[j])
+			}
+		}
+	}
+}
+
+func TestSearchParams(t *testing.T) {
+	tests := []struct {
+		query Query
+		path  string
+		exp   map[string]string
+	}{
+		{
+			NewQuery(testIndexTitle, "سمفونی مردگان"),
+			"/search/bibliographicSimpleSearchProcess.do",
+			map[string]string{
+				"simpleSearch.value":                           "سمفونی مردگان",
+				"simpleSearch.indexFieldId":                    string(testIndexTitle),
+				"bibliographicLimitQueryBuilder.biblioDocType": "BF",
+			},
+		},
+		{
+			NewQuery(testIndexAuthor, "معروفی").Or(testIndexAuthor, "گلشیری").Not(testIndexSubject, "شعر"),
+			"/search/bibliographicAdvancedSearchProcess.do",
+			map[string]string{
+				"advancedSearch.simpleSearch[0].value":        "معروفی",
+				"advancedSearch.simpleSearch[0].indexFieldId": string(testIndexAuthor),
+				"advancedSearch.simpleSearch[0].operator":     "",
+				"advancedSearch.simpleSearch[1].value":        "گلشیری",
+				"advancedSearch.simpleSearch[1].operator":     "or",
+				"advancedSearch.simpleSearch[2].indexFieldId": string(testIndexSubject),
+				"advancedSearch.simpleSearch[2].operator":     "not",
+			},
+		},
+		{
+			Query{Terms: []Term{{Index: testIndexYear, Value: "۱۳۹۷"}, {Index: testIndexTitle, Value: "رمان"}}},
+			"/search/bibliographicAdvancedSearchProcess.do",
+			map[string]string{
+				"advancedSearch.simpleSearch[1].operator":      "and",
+				"bibliographicLimitQueryBuilder.biblioDocType": "",
+			},
+		},
+	}
+
+	var path string
+	var params url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path, params = r.URL.Path, r.URL.Query()
+		fmt.Fprint(w, `<table></table>`)
+	}))
+	defer ts.Close()
+	c := NewClient(WithBaseURL(ts.URL))
+
+	for i, test := range tests {
+		if _, err := c.Search(context.Background(), test.query); err != nil {
+			t.Errorf("Test %d: Error on searching %v: %s", i, test.query, err)
+			continue
+		}
+		if path != test.path {
+			t.Errorf("Test %d: Expected path %s, but got %s", i, test.path, path)
+		}
+		for key, exp := range test.exp {
+			if value := params.Get(key); value != exp {
+				t.Errorf("Test %d: Expected %s '%s', but got '%s'", i, key, exp, value)
+			}
+		}
+	}
+
+	if _, err := c.Search(context.Background(), Query{}); !errors.Is(err, ErrEmptyQuery) {
+		t.Errorf("Expected empty query error, but got %v", err)
+	}
+}
+
+// pagedServer serves the results of a search for total records, ten to a
+// page, linking each page to the next by its "بعدی" link. It pages through
+// the search of the session: a search starts a session and a page request
+// without its cookie gets the first page again. This models the paging the
+// iterator expects, not pages recorded from the OPAC; see reSearchTotal.
+func pagedServer(total int, requests *int) *httptest.Server {
+	sessions := 0
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		page := 1
+		if r.URL.Query().Get("pageNumber") == "" {
+			sessions++
+			http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: strconv.Itoa(sessions), Path: "/"})
+		} else if cookie, err := r.Cookie("JSESSIONID"); err == nil && cookie.Value == strconv.Itoa(sessions) {
+			page, _ = strconv.Atoi(r.URL.Query().Get("pageNumber"))
+		}
+
+		fmt.Fprintf(w, `<table class="listcontent"><tr><td class="listheader" colspan="5">‏تعداد نتایج: %s</td></tr>`,
+			persianDigits(strconv.Itoa(total)))
+		for i := (page-1)*10 + 1; i <= page*10 && i <= total; i++ {
+			fmt.Fprintf(w, `<tr><td id="td1">%d</td><td id="td2"><a href="/opac-prod/search/briefListSearch.do?command=FULL_VIEW&amp;id=%d">کتاب %d</a></td><td id="td3"></td><td id="td4"></td><td id="td5">۱۳۹۷</td></tr>`, i, 1000+i, i)
+		}
+		fmt.Fprint(w, `</table>`)
+		if page*10 < total {
+			fmt.Fprintf(w, `<a href="/opac-prod/search/briefListSearch.do?command=PAGING&amp;pageNumber=%d">بعدی</a>`, page+1)
+		}
+	}))
+}
+
+func persianDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return '۰' + r - '0'
+		}
+		return r
+	}, s)
+}
+
+func TestSearchIterator(t *testing.T) {
+	tests := []struct {
+		total    int
+		max      int
+		exp      int
+		requests int
+	}{
+		{23, 0, 23, 3},
+		{23, 15, 15, 2},
+		{20, 0, 20, 2},
+		{20, 10, 10, 1},
+		{5, 100, 5, 1},
+		{0, 0, 0, 1},
+	}
+
+	for i, test := range tests {
+		requests := 0
+		ts := pagedServer(test.total, &requests)
+		c := NewClient(WithBaseURL(ts.URL))
+
+		it := c.SearchIterator(context.Background(), NewQuery(testIndexPublisher, "ققنوس"), test.max)
+		n := 0
+		for it.Next() {
+			n++
+			if id := it.Result().ID; id != strconv.Itoa(1000+n) {
+				t.Errorf("Test %d: Expected result %d id %d, but got %s", i, n, 1000+n, id)
+			}
+		}
+		ts.Close()
+
+		if err := it.Err(); err != nil {
+			t.Errorf("Test %d: Error on iterating search results: %s", i, err)
+		}
+		if n != test.exp {
+			t.Errorf("Test %d: Expected %d results, but got %d", i, test.exp, n)
+		}
+		if total := it.Total(); total != test.total {
+			t.Errorf("Test %d: Expected total %d, but got %d", i, test.total, total)
+		}
+		if requests != test.requests {
+			t.Errorf("Test %d: Expected %d requests, but got %d", i, test.requests, requests)
+		}
+	}
+}
+
+func TestSearchIteratorRepeatedPage(t *testing.T) {
+	requests := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `<table><tr><td id="td2"><a href="/opac-prod/search/briefListSearch.do?command=FULL_VIEW&amp;id=1001">کتاب ۱</a></td></tr></table>`)
+		fmt.Fprint(w, `<a href="/opac-prod/search/briefListSearch.do?command=PAGING&amp;pageNumber=2">بعدی</a>`)
+	}))
+	defer ts.Close()
+
+	it := NewClient(WithBaseURL(ts.URL)).SearchIterator(context.Background(), NewQuery(testIndexTitle, "کتاب"), 0)
+	n := 0
+	for it.Next() {
+		n++
+	}
+	if err := it.Err(); err != nil {
+		t.Errorf("Error on iterating search results: %s", err)
+	}
+	if n != 1 || requests != 2 {
+		t.Errorf("Expected 1 result from 2 requests, but got %d from %d", n, requests)
+	}
+}
+
+func TestSearchIteratorError(t *testing.T) {
+	it := SearchIterator(context.Background(), Query{}, 0)
+	if it.Next() {
+		t.Error("Expected no results for empty query")
+	}
+	if err := it.Err(); !errors.Is(err, ErrEmptyQuery) {
+		t.Errorf("Expected empty query error, but got %v", err)
+	}
+
+	ts := httptest.NewServer(http.NotFoundHandler())
+	defer ts.Close()
+	it = NewClient(WithBaseURL(ts.URL)).SearchIterator(context.Background(), NewQuery(testIndexTitle, "x"), 0)
+	if it.Next() {
+		t.Error("Expected no results on missing page")
+	}
+	if it.Err() == nil {
+		t.Error("Expected error on missing page, but got nil")
+	}
+}
@@ -0,0 +1,84 @@
+package melli
+
+import (
+	"github.com/ketabchi/melli/date"
+)
+
+// Author is the main entry (سرشناسه) of a book or one of its co-authors
+// from the added entries. Corporate authors have only a Name.
+type Author struct {
+	Name      string     `json:"name"`
+	Given     string     `json:"given,omitempty"`
+	Family    string     `json:"family,omitempty"`
+	Born      *date.Year `json:"born,omitempty"`
+	Died      *date.Year `json:"died,omitempty"`
+	English   string     `json:"english,omitempty"`
+	Corporate bool       `json:"corporate,omitempty"`
+}
+
+// Authors returns the main entry of the book followed by the added entries
+// that are co-authors: those with an author role, and personal names with
+// no role that the statement of responsibility names as authors.
+func (b *Book) Authors() []Author {
+	authors := make([]Author, 0)
+	if text := b.fields.Get(LabelMainEntry); text != "" {
+		authors = append(authors, authorFromHeading(parseHeading(text)))
+	}
+
+	stated := []Contributor{}
+	if text := b.fields.Get(LabelTitle); text != "" {
+		stated = b.contributorsFromField(text)
+	}
+	for _, text := range b.fields.Values(LabelAddedEntry) {
+		h := parseHeading(text)
+		if !isCoAuthor(h, stated) {
+			continue
+		}
+
+		a := authorFromHeading(h)
+		dup := false
+		for _, other := range authors {
+			dup = dup || nameKey(other.Name) == nameKey(a.Name)
+		}
+		if !dup {
+			authors = append(authors, a)
+		}
+	}
+
+	return authors
+}
+
+func authorFromHeading(h heading) Author {
+	a := Author{
+		Name:      h.direct(),
+		Born:      h.born,
+		Died:      h.died,
+		English:   h.latinDirect(),
+		Corporate: h.corporate,
+	}
+	if !a.Corporate {
+		a.Given, a.Family = h.given, h.family
+	}
+
+	return a
+}
+
+func isCoAuthor(h heading, stated []Contributor) bool {
+	for _, role := range h.roles {
+		if role == RoleAuthor {
+			return true
+		}
+	}
+	if len(h.roles) > 0 || h.corporate {
+		return false
+	}
+
+	key := nameKey(h.direct())
+	for _, c := range stated {
+		if nameKey(c.Name) == key && c.Role == RoleAuthor {
+			return true
+		}
+	}
+
+	return false
+}
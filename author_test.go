@@ -0,0 +1,113 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ketabchi/melli/date"
+)
+
+func TestAuthors(t *testing.T) {
+	tests := []struct {
+		url string
+		exp []Author
+	}{
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/3997499",
+			[]Author{
+				{Name: "لوسی هاوکینگ", Given: "لوسی", Family: "هاوکینگ", English: "Lucy Hawking"},
+				{
+					Name: "استیون هاوکینگ", Given: "استیون", Family: "هاوکینگ", English: "Stephen Hawking",
+					Born: &date.Year{Value: 1942, Calendar: date.Gregorian},
+					Died: &date.Year{Value: 2018, Calendar: date.Gregorian},
+				},
+			},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/636958",
+			[]Author{
+				{
+					Name: "عباس معروفی", Given: "عباس", Family: "معروفی",
+					Born: &date.Year{Value: 1336, Calendar: date.Jalali, Open: true},
+				},
+			},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/1127515",
+			[]Author{
+				{
+					Name: "افلاطون", Family: "افلاطون", English: "Plato",
+					Born: &date.Year{Value: -427, Calendar: date.Gregorian, Uncertain: true},
+					Died: &date.Year{Value: -347, Calendar: date.Gregorian},
+				},
+			},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/3735689",
+			[]Author{
+				{
+					Name: "زین‌الدین‌بن علی شهیدثانی", Given: "زین‌الدین‌بن علی", Family: "شهیدثانی",
+					Born: &date.Year{Value: 911, Calendar: date.Hijri},
+					Died: &date.Year{Value: 966, Calendar: date.Hijri},
+				},
+			},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/1070294",
+			[]Author{},
+		},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if authors := book.Authors(); !reflect.DeepEqual(authors, test.exp) {
+			t.Errorf("Test %d: Expected authors %+v, but got %+v", i, test.exp, authors)
+		}
+	}
+}
+
+func TestAuthorFromHeading(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Author
+	}{
+		{
+			"‏حافظ، شمس‌الدین محمد، -۷۹۲ق.\n‏Hafiz, Shams al-Din Muhammad",
+			Author{
+				Name: "شمس‌الدین محمد حافظ", Given: "شمس‌الدین محمد", Family: "حافظ", English: "Shams al-Din Muhammad Hafiz",
+				Died: &date.Year{Value: 792, Calendar: date.Hijri},
+			},
+		},
+		{
+			"‏معروفی، عباس، ۱۳۳۶-",
+			Author{
+				Name: "عباس معروفی", Given: "عباس", Family: "معروفی",
+				Born: &date.Year{Value: 1336, Calendar: date.Jalali, Open: true},
+			},
+		},
+		{
+			"‏ایران. مجلس شورای اسلامی. کتابخانه، موزه و مرکز اسناد",
+			Author{Name: "ایران. مجلس شورای اسلامی. کتابخانه، موزه و مرکز اسناد", Corporate: true},
+		},
+	}
+
+	for i, test := range tests {
+		if a := authorFromHeading(parseHeading(test.text)); !reflect.DeepEqual(a, test.exp) {
+			t.Errorf("Test %d: Expected author %+v, but got %+v", i, test.exp, a)
+		}
+	}
+}
+
+func TestAuthorNamesFromCorporateHeading(t *testing.T) {
+	b := &Book{}
+	faName, enName := b.authorNamesFromField("‏دانشگاه تهران.\n‏University of Tehran")
+	if faName != "دانشگاه تهران" || enName != "University of Tehran" {
+		t.Errorf("Expected author 'دانشگاه تهران' and 'University of Tehran', but got '%s' and '%s'",
+			faName, enName)
+	}
+}
@@ -1,21 +1,25 @@
 package melli
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"net/http"
+	"io"
+	neturl "net/url"
 	"regexp"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
 
-	"github.com/ketabchi/melli/api"
+	"github.com/ketabchi/melli/internal/persian"
+	"github.com/ketabchi/melli/isbn"
 	"github.com/ketabchi/util"
 )
 
 type Book struct {
-	url string
-	doc *goquery.Document
+	url    string
+	doc    *goquery.Document
+	fields Fields
 }
 
 var (
@@ -26,36 +30,45 @@ var (
 	reNumber           = regexp.MustCompile(`[0-9۰-۹]`)
 
 	ErrNoBook = errors.New("no book with this isbn")
+	// ErrInvalidISBN is wrapped by the errors NewBookByISBN returns for
+	// invalid ISBNs.
+	ErrInvalidISBN = isbn.ErrInvalidISBN
 )
 
-func NewBookByISBN(isbn string, args ...string) (*Book, error) {
-	url, err := api.GetBookURLByISBN(isbn, args...)
-	if err != nil {
-		return nil, err
-	}
-	if url == "" {
-		return nil, ErrNoBook
-	}
+func NewBookByISBN(number string, args ...string) (*Book, error) {
+	return DefaultClient.NewBookByISBN(context.Background(), number, args...)
+}
 
-	return NewBook(url)
+func NewBookByID(id string) (*Book, error) {
+	return DefaultClient.NewBookByID(context.Background(), id)
 }
 
 func NewBook(url string) (*Book, error) {
-	res, err := http.Get(url)
-	if err != nil {
-		return nil, err
-	}
+	return DefaultClient.NewBook(context.Background(), url)
+}
 
-	doc, err := goquery.NewDocumentFromResponse(res)
+// ParseBook reads a saved bibliographic record page from r. url is what
+// Link returns for the book.
+func ParseBook(r io.Reader, url string) (*Book, error) {
+	doc, err := goquery.NewDocumentFromReader(r)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Book{url: url, doc: doc}, nil
+	return newBook(url, doc), nil
+}
+
+// ParseBookHTML is like ParseBook but takes the page as a string.
+func ParseBookHTML(html string, url string) (*Book, error) {
+	return ParseBook(strings.NewReader(html), url)
+}
+
+func newBook(url string, doc *goquery.Document) *Book {
+	return &Book{url: url, doc: doc, fields: parseFields(doc)}
 }
 
 func (b *Book) Name() (name string) {
-	if text := b.getField("\u200fعنوان و نام پديدآور"); text != "" {
+	if text := b.fields.Get(LabelTitle); text != "" {
 		return b.nameFromField(text)
 	}
 
@@ -71,7 +84,7 @@ func (b *Book) nameFromField(text string) string {
 }
 
 func (b *Book) Publisher() (publisher string) {
-	if text := b.getField("\u200fمشخصات نشر"); text != "" {
+	if text := b.fields.Get(LabelPublication); text != "" {
 		return b.publisherFromField(text)
 	}
 
@@ -98,13 +111,19 @@ func (b *Book) publisherFromField(text string) string {
 }
 
 func (b *Book) Author() (faName string, enName string) {
-	if text := b.getField("\u200fسرشناسه"); text != "" {
-		splited := strings.Split(text, "\n")
+	if text := b.fields.Get(LabelMainEntry); text != "" {
+		return b.authorNamesFromField(text)
+	}
 
-		faName = b.authorFromField(splited[0])
-		if len(splited) > 1 {
-			enName = b.authorEnFromField(splited[1])
-		}
+	return
+}
+
+func (b *Book) authorNamesFromField(text string) (faName string, enName string) {
+	splited := strings.Split(text, "\n")
+
+	faName = b.authorFromField(splited[0])
+	if len(splited) > 1 {
+		enName = b.authorEnFromField(splited[1])
 	}
 
 	return
@@ -124,9 +143,11 @@ func (b *Book) authorEnFromField(text string) string {
 	return b.authorFullName(splited)
 }
 
+// authorFullName returns the direct form of an inverted name. Corporate
+// headings, which have no comma, are returned as they are.
 func (b *Book) authorFullName(splited []string) string {
 	if len(splited) < 2 {
-		return ""
+		return strings.TrimSuffix(util.Clean(splited[0]), ".")
 	}
 
 	fn := util.Clean(splited[1])
@@ -145,31 +166,54 @@ func (b *Book) authorFullName(splited []string) string {
 	return name
 }
 
+// OriginalName returns the original title given in the "عنوان اصلی" note,
+// which need not be the first note of the record.
 func (b *Book) OriginalName() (name string) {
-	if text := b.getField("\u200fيادداشت"); text != "" {
-		if !strings.Contains(text, "عنوان اصلی:") {
-			return ""
+	for _, text := range b.fields.Values(LabelNote) {
+		if name := b.originalNameFromField(text); name != "" {
+			return name
 		}
+	}
 
-		text = strings.ReplaceAll(text, "عنوان اصلی:", "")
-		text = util.Clean(text)
-		text = strings.ReplaceAll(text, "\u202d", "")
-		text = strings.ReplaceAll(text, "\u200e", "")
-		text = reCleanPubDate.ReplaceAllString(text, "")
-		text = strings.ReplaceAll(text, ",", "")
+	return ""
+}
 
-		name = strings.Trim(text, ".[] ")
+func (b *Book) originalNameFromField(text string) string {
+	if !strings.Contains(text, "عنوان اصلی:") {
+		return ""
 	}
 
-	return
+	text = strings.ReplaceAll(text, "عنوان اصلی:", "")
+	text = util.Clean(text)
+	text = strings.ReplaceAll(text, "\u202d", "")
+	text = strings.ReplaceAll(text, "\u200e", "")
+	text = reCleanPubDate.ReplaceAllString(text, "")
+	text = strings.ReplaceAll(text, ",", "")
+
+	return strings.Trim(text, ".[] ")
 }
 
+// Translators returns the translators named in the statement of
+// responsibility, or those of the added entries if none are found there.
 func (b *Book) Translators() []string {
-	if text := b.getField("\u200fعنوان و نام پديدآور"); text != "" {
-		return b.translatorsFromField(text)
+	translators := []string{}
+	if text := b.fields.Get(LabelTitle); text != "" {
+		translators = b.translatorsFromField(text)
+	}
+	if len(translators) > 0 {
+		return translators
 	}
 
-	return []string{}
+	for _, e := range b.AddedEntries() {
+		for _, role := range e.Roles {
+			if role == RoleTranslator {
+				translators = append(translators, e.Name)
+				break
+			}
+		}
+	}
+
+	return translators
 }
 
 // TODO: samples we can't currently parse:
@@ -204,26 +248,64 @@ func (b *Book) translatorsFromField(text string) []string {
 	return translators
 }
 
+// ISBN returns the first ISBN of the book as ISBN-13, or as given when its
+// check digit is invalid. See ISBNs for the others.
 func (b *Book) ISBN() (isbn string) {
-	if text := b.getField("\u200f‏شابک"); text != "" {
-		return b.isbnFromField(text)
+	for _, entry := range b.ISBNs() {
+		if entry.Valid {
+			return entry.ISBN13
+		}
+		return entry.Raw
 	}
 
 	return ""
 }
 
-func (b *Book) isbnFromField(text string) string {
-	// TODO: needs more parsing text, fails on:
-	// http://opac.nlai.ir/opac-prod/bibliographic/2072242
-	return strings.ReplaceAll(text, "-", "")
-}
-
 func (b *Book) Link() string {
 	return b.url
 }
 
+// RecordID returns the NLAI record id of the book, taken from its link.
+func (b *Book) RecordID() string {
+	u, err := neturl.Parse(b.url)
+	if err != nil {
+		return ""
+	}
+	if i := strings.LastIndex(u.Path, "/bibliographic/"); i >= 0 {
+		return strings.Trim(u.Path[i+len("/bibliographic/"):], "/")
+	}
+
+	return u.Query().Get("id")
+}
+
+// NationalBibliographyNumber returns the national bibliography number
+// (شماره کتابشناسی ملی) with Latin digits, keeping letters as in "م79-9203".
+func (b *Book) NationalBibliographyNumber() string {
+	if text := b.fields.Get(LabelNationalBibliographyNumber); text != "" {
+		return b.nationalBibliographyNumberFromField(text)
+	}
+
+	return ""
+}
+
+func (b *Book) nationalBibliographyNumberFromField(text string) string {
+	return persian.Digits(strings.ReplaceAll(clean(text), " ", ""))
+}
+
+// Fields returns the labeled rows of the record page.
+func (b *Book) Fields() Fields {
+	return b.fields
+}
+
+// RawFields returns the unparsed values of every row of the record page,
+// keyed by normalized label. It gives access to fields the accessors don't
+// parse.
+func (b *Book) RawFields() map[string][]string {
+	return b.fields.Map()
+}
+
 func (b *Book) Series() (ss []string) {
-	if text := b.getField("\u200fفروست"); text != "" {
+	if text := b.fields.Get(LabelSeries); text != "" {
 		return b.seriesFromField(text)
 	}
 
@@ -249,15 +331,3 @@ func (b *Book) seriesFromField(text string) []string {
 
 	return series
 }
-
-func (b *Book) getField(key string) (ret string) {
-	b.doc.Find("td").EachWithBreak(func(i int, sel *goquery.Selection) bool {
-		if sel.Text() == key {
-			ret = sel.Next().Next().Text()
-			return false
-		}
-		return true
-	})
-
-	return
-}
@@ -42,7 +42,7 @@ func TestName(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		book, err := NewBook(test.url)
+		book, err := testBook(test.url)
 		if err != nil {
 			t.Errorf("Test %d: Error on creating book from %s: %s",
 				i, test.url, err)
@@ -123,7 +123,7 @@ func TestPublisher(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		book, err := NewBook(test.url)
+		book, err := testBook(test.url)
 		if err != nil {
 			t.Errorf("Test %d: Error on creating book from %s: %s",
 				i, test.url, err)
@@ -185,7 +185,7 @@ func TestAuthor(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		book, err := NewBook(test.url)
+		book, err := testBook(test.url)
 		if err != nil {
 			t.Errorf("Test %d: Error on creating book from %s: %s",
 				i, test.url, err)
@@ -249,7 +249,7 @@ func TestOriginalName(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		book, err := NewBook(test.url)
+		book, err := testBook(test.url)
 		if err != nil {
 			t.Errorf("Test %d: Error on creating book from %s: %s",
 				i, test.url, err)
@@ -406,7 +406,7 @@ func TestTranslator(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		book, err := NewBook(test.url)
+		book, err := testBook(test.url)
 		if err != nil {
 			t.Errorf("Test %d: Error on creating book from %s: %s",
 				i, test.url, err)
@@ -450,7 +450,7 @@ func TestSerie(t *testing.T) {
 	}
 
 	for i, test := range tests {
-		book, err := NewBook(test.url)
+		book, err := testBook(test.url)
 		if err != nil {
 			t.Errorf("Test %d: Error on creating book from %s: %s",
 				i, test.url, err)
@@ -461,3 +461,89 @@ func TestSerie(t *testing.T) {
 		}
 	}
 }
+
+func TestParseBookHTML(t *testing.T) {
+	html := `<table>
+<tr><td>` + "‏" + `عنوان و نام پديدآور</td><td>:</td><td>` + "‏" + `سمفونی مردگان/ عباس معروفی.</td></tr>
+<tr><td>` + "‏" + `مشخصات نشر</td><td>:</td><td>` + "‏" + `تهران: ققنوس، ۱۳۸۶.</td></tr>
+</table>`
+	url := "http://opac.nlai.ir/opac-prod/bibliographic/636958"
+
+	book, err := ParseBookHTML(html, url)
+	if err != nil {
+		t.Fatalf("Error on parsing book html: %s", err)
+	}
+	if name := book.Name(); name != "سمفونی مردگان" {
+		t.Errorf("Expected book name 'سمفونی مردگان', but got '%s'", name)
+	}
+	if publisher := book.Publisher(); publisher != "ققنوس" {
+		t.Errorf("Expected publisher name 'ققنوس', but got '%s'", publisher)
+	}
+	if link := book.Link(); link != url {
+		t.Errorf("Expected link '%s', but got '%s'", url, link)
+	}
+}
+
+func TestRecordID(t *testing.T) {
+	tests := []struct {
+		url string
+		exp string
+	}{
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/636958",
+			"636958",
+		},
+		{
+			"https://opac.nlai.ir/opac-prod/bibliographic/5481844/",
+			"5481844",
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/search/briefListSearch.do?command=FULL_VIEW&id=4634555&pageStatus=0",
+			"4634555",
+		},
+		{
+			"",
+			"",
+		},
+	}
+
+	for i, test := range tests {
+		book := &Book{url: test.url}
+		if id := book.RecordID(); id != test.exp {
+			t.Errorf("Test %d: Expected record id '%s', but got '%s'",
+				i, test.exp, id)
+		}
+	}
+}
+
+func TestNationalBibliographyNumber(t *testing.T) {
+	tests := []struct {
+		url string
+		exp string
+	}{
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/636958",
+			"1153209",
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/1070294",
+			"م79-9203",
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/5483716",
+			"",
+		},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+		}
+		if nbn := book.NationalBibliographyNumber(); nbn != test.exp {
+			t.Errorf("Test %d: Expected national bibliography number '%s', but got '%s'",
+				i, test.exp, nbn)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// Classification is a class number from the Dewey (رده بندی دیویی) or the
+// Library of Congress (رده بندی کنگره) rows, with Persian digits and the
+// Persian decimal slash normalized.
+type Classification struct {
+	Raw    string `json:"raw"`
+	Prefix string `json:"prefix,omitempty"`
+	Class  string `json:"class"`
+	Cutter string `json:"cutter,omitempty"`
+	Year   string `json:"year,omitempty"`
+}
+
+var (
+	reClassPrefix = regexp.MustCompile(`^\[([^\]]+)\]\s*`)
+	reClassYear   = regexp.MustCompile(`\s+(\d{4})\s*\.?$`)
+	reDigits      = regexp.MustCompile(`^\d+$`)
+)
+
+// String returns the class number, cutter and year separated by spaces.
+func (c Classification) String() string {
+	ss := make([]string, 0, 3)
+	for _, s := range []string{c.Class, c.Cutter, c.Year} {
+		if s != "" {
+			ss = append(ss, s)
+		}
+	}
+
+	return strings.Join(ss, " ")
+}
+
+func (b *Book) Dewey() Classification {
+	if text := b.fields.Get(LabelDewey); text != "" {
+		return b.deweyFromField(text)
+	}
+
+	return Classification{}
+}
+
+func (b *Book) deweyFromField(text string) Classification {
+	c := Classification{Raw: clean(text)}
+
+	text = persian.Digits(c.Raw)
+	if ss := reClassPrefix.FindStringSubmatch(text); len(ss) > 1 {
+		c.Prefix = strings.TrimSpace(ss[1])
+		text = text[len(ss[0]):]
+	}
+	if ss := reClassYear.FindStringSubmatch(text); len(ss) > 1 {
+		c.Year = ss[1]
+		text = text[:len(text)-len(ss[0])]
+	}
+
+	ss := strings.Fields(text)
+	if len(ss) == 0 {
+		return c
+	}
+	c.Class = strings.ReplaceAll(ss[0], "/", ".")
+	c.Cutter = strings.Join(ss[1:], "")
+
+	return c
+}
+
+func (b *Book) LCC() Classification {
+	if text := b.fields.Get(LabelLCC); text != "" {
+		return b.lccFromField(text)
+	}
+
+	return Classification{}
+}
+
+func (b *Book) lccFromField(text string) Classification {
+	c := Classification{Raw: clean(text)}
+
+	text = persian.Digits(c.Raw)
+	if ss := reClassYear.FindStringSubmatch(text); len(ss) > 1 {
+		c.Year = ss[1]
+		text = text[:len(text)-len(ss[0])]
+	}
+
+	ss := strings.Split(text, "/")
+	c.Class = strings.TrimSpace(ss[0])
+	for i, s := range ss[1:] {
+		s = strings.TrimSpace(s)
+		if reDigits.MatchString(s) {
+			c.Class += "." + s
+			continue
+		}
+		c.Cutter = strings.ReplaceAll(strings.Join(ss[i+1:], "/"), " ", "")
+		break
+	}
+
+	return c
+}
@@ -0,0 +1,97 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeweyFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Classification
+	}{
+		{
+			"‏‫۸۱۳/۶‬",
+			Classification{Raw: "۸۱۳/۶", Class: "813.6"},
+		},
+		{
+			"‏‫[ج] ۸۱۳/۶‬",
+			Classification{Raw: "[ج] ۸۱۳/۶", Prefix: "ج", Class: "813.6"},
+		},
+		{
+			"‏‫۸فا۳/۶۲‬",
+			Classification{Raw: "۸فا۳/۶۲", Class: "8فا3.62"},
+		},
+		{
+			"‏‫۹۷۳/۹۳۲۰۹۲‬",
+			Classification{Raw: "۹۷۳/۹۳۲۰۹۲", Class: "973.932092"},
+		},
+		{
+			"‏‫۲۹۷/۲۱۸ ف۸۲۷ ۱۳۹۰‬",
+			Classification{Raw: "۲۹۷/۲۱۸ ف۸۲۷ ۱۳۹۰", Class: "297.218", Cutter: "ف827", Year: "1390"},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if c := b.deweyFromField(test.text); !reflect.DeepEqual(c, test.exp) {
+			t.Errorf("Test %d: Expected dewey %+v, but got %+v", i, test.exp, c)
+		}
+	}
+}
+
+func TestLCCFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Classification
+	}{
+		{
+			"‏‫PIR۸۰۴۷‬‏/‫ع۲۷‬‏‫س۸ ۱۳۸۶‬",
+			Classification{Raw: "PIR۸۰۴۷/ع۲۷س۸ ۱۳۸۶", Class: "PIR8047", Cutter: "ع27س8", Year: "1386"},
+		},
+		{
+			"‏‫BP۱۵۵/۵‬‏/‫ج۹ط۸ ۱۳۹۱‬",
+			Classification{Raw: "BP۱۵۵/۵/ج۹ط۸ ۱۳۹۱", Class: "BP155.5", Cutter: "ج9ط8", Year: "1391"},
+		},
+		{
+			"‏‫HF۵۳۸۱‬‏/‫ت۹ش۷ ۱۳۹۲‬",
+			Classification{Raw: "HF۵۳۸۱/ت۹ش۷ ۱۳۹۲", Class: "HF5381", Cutter: "ت9ش7", Year: "1392"},
+		},
+		{
+			"‏‫PZ۷‬",
+			Classification{Raw: "PZ۷", Class: "PZ7"},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if c := b.lccFromField(test.text); !reflect.DeepEqual(c, test.exp) {
+			t.Errorf("Test %d: Expected lcc %+v, but got %+v", i, test.exp, c)
+		}
+	}
+}
+
+func TestDeweyCategories(t *testing.T) {
+	tests := []struct {
+		number   string
+		class    string
+		division string
+		ok       bool
+	}{
+		{"813.6", "Literature", "American literature in English", true},
+		{"۸فا۳/۶۲", "Literature", "Persian literature", true},
+		{"۱۵۳/۸۳", "Philosophy & psychology", "Psychology", true},
+		{"297.61", "Religion", "Other religions", true},
+		{"040", "Computer science, information & general works", "", false},
+		{"", "", "", false},
+		{"PZ7", "", "", false},
+	}
+
+	for i, test := range tests {
+		class, division, ok := DeweyCategories(test.number)
+		if ok != test.ok || class.En != test.class || division.En != test.division {
+			t.Errorf("Test %d: Expected (%q, %q, %t), but got (%q, %q, %t)",
+				i, test.class, test.division, test.ok, class.En, division.En, ok)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package melli
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/ketabchi/melli/api"
+)
+
+// DefaultClient is used by NewBook and NewBookByISBN.
+var DefaultClient = NewClient()
+
+// Client creates books from the NLAI OPAC.
+type Client struct {
+	api *api.Client
+}
+
+// Option configures a Client.
+type Option = api.Option
+
+// WithHTTPClient sets the http.Client used for every request.
+func WithHTTPClient(hc *http.Client) Option {
+	return api.WithHTTPClient(hc)
+}
+
+// WithBaseURL points the client at another OPAC root.
+func WithBaseURL(u string) Option {
+	return api.WithBaseURL(u)
+}
+
+// WithUserAgent sets the User-Agent header sent with every request.
+func WithUserAgent(ua string) Option {
+	return api.WithUserAgent(ua)
+}
+
+// WithTimeout limits the time spent on a single request.
+func WithTimeout(d time.Duration) Option {
+	return api.WithTimeout(d)
+}
+
+// NewClient returns a Client configured by opts.
+func NewClient(opts ...Option) *Client {
+	return &Client{api: api.NewClient(opts...)}
+}
+
+// NewBookByISBN searches for the ISBN number and fetches the book found.
+// An error wrapping ErrInvalidISBN is returned if number isn't a valid
+// ISBN. See api.Client.GetBookURLByISBN for the meaning of args.
+func (c *Client) NewBookByISBN(ctx context.Context, number string, args ...string) (*Book, error) {
+	url, err := c.api.GetBookURLByISBN(ctx, number, args...)
+	if err != nil {
+		return nil, err
+	}
+	if url == "" {
+		return nil, ErrNoBook
+	}
+
+	return c.NewBook(ctx, url)
+}
+
+// Search returns the records on the first page of results of q. See
+// api.Client.Search.
+func (c *Client) Search(ctx context.Context, q api.Query) ([]api.SearchResult, error) {
+	return c.api.Search(ctx, q)
+}
+
+// SearchIterator returns an iterator over up to max results of q. See
+// api.Client.SearchIterator.
+func (c *Client) SearchIterator(ctx context.Context, q api.Query, max int) *api.Iterator {
+	return c.api.SearchIterator(ctx, q, max)
+}
+
+// NewBookByID fetches the bibliographic record page with the given NLAI
+// record id.
+func (c *Client) NewBookByID(ctx context.Context, id string) (*Book, error) {
+	return c.NewBook(ctx, c.api.BookURL(id))
+}
+
+// NewBook fetches the bibliographic record page at url.
+func (c *Client) NewBook(ctx context.Context, url string) (*Book, error) {
+	doc, err := c.api.Get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBook(url, doc), nil
+}
@@ -0,0 +1,42 @@
+package melli
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"testing"
+)
+
+func TestNewBookByID(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeFile(w, r, path.Join(fixtureDir, path.Base(r.URL.Path)+".html"))
+	}))
+	defer ts.Close()
+
+	c := NewClient(WithBaseURL(ts.URL))
+	book, err := c.NewBookByID(context.Background(), "636958")
+	if err != nil {
+		t.Fatalf("Error on creating book by id: %s", err)
+	}
+	if link := book.Link(); link != ts.URL+"/bibliographic/636958" {
+		t.Errorf("Expected link '%s', but got '%s'", ts.URL+"/bibliographic/636958", link)
+	}
+	if id := book.RecordID(); id != "636958" {
+		t.Errorf("Expected record id '636958', but got '%s'", id)
+	}
+	if name := book.Name(); name != "سمفونی مردگان" {
+		t.Errorf("Expected book name 'سمفونی مردگان', but got '%s'", name)
+	}
+
+	if _, err := c.NewBookByID(context.Background(), "1"); err == nil {
+		t.Error("Expected error on missing record, but got nil")
+	}
+}
+
+func TestNewBookByInvalidISBN(t *testing.T) {
+	if _, err := NewBookByISBN("978-600-8237-63-2"); !errors.Is(err, ErrInvalidISBN) {
+		t.Errorf("Expected invalid isbn error, but got %v", err)
+	}
+}
@@ -0,0 +1,221 @@
+package melli
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Role is how a contributor took part in making a book, as a MARC relator
+// code.
+type Role string
+
+const (
+	RoleAuthor       Role = "aut"
+	RoleTranslator   Role = "trl"
+	RoleEditor       Role = "edt"
+	RoleIllustrator  Role = "ill"
+	RoleCompiler     Role = "com"
+	RoleNarrator     Role = "nrt"
+	RolePhotographer Role = "pht"
+	RolePrefacer     Role = "aui"
+	RoleAdapter      Role = "adp"
+	RoleLyricist     Role = "lyr"
+	RoleResearcher   Role = "res"
+	RoleCommentator  Role = "cwt"
+	RoleContributor  Role = "ctb"
+)
+
+// Contributor is a person or body named in the statement of responsibility
+// or the added entries of a book.
+type Contributor struct {
+	Name string `json:"name"`
+	Role Role   `json:"role"`
+}
+
+// roleWords are the words NLAI uses for roles, both the verbal nouns of
+// statements of responsibility ("ترجمه") and the agent nouns of added
+// entries ("مترجم").
+var roleWords = []struct {
+	word string
+	role Role
+}{
+	{"نویسنده", RoleAuthor}, {"نویسندگان", RoleAuthor}, {"نوشته", RoleAuthor},
+	{"نوشته‌ی", RoleAuthor}, {"تالیف", RoleAuthor}, {"تألیف", RoleAuthor},
+	{"مولف", RoleAuthor}, {"مؤلف", RoleAuthor}, {"مولفان", RoleAuthor},
+	{"شعر", RoleAuthor}, {"شاعر", RoleAuthor},
+	{"ترجمه", RoleTranslator}, {"ترجمه‌ی", RoleTranslator}, {"ترجمه ی", RoleTranslator},
+	{"مترجم", RoleTranslator}, {"مترجمان", RoleTranslator}, {"مترجمین", RoleTranslator},
+	{"برگردان", RoleTranslator},
+	{"ویرایش", RoleEditor}, {"ویراستار", RoleEditor}, {"ویراسته", RoleEditor},
+	{"به کوشش", RoleEditor}, {"به اهتمام", RoleEditor}, {"زیر نظر", RoleEditor},
+	{"سرپرستی", RoleEditor}, {"تصحیح", RoleEditor}, {"مصحح", RoleEditor},
+	{"تصویرگر", RoleIllustrator}, {"تصویرگری", RoleIllustrator},
+	{"تصویرگران", RoleIllustrator}, {"نقاش", RoleIllustrator}, {"نقاشی", RoleIllustrator},
+	{"گردآورنده", RoleCompiler}, {"گردآوری", RoleCompiler}, {"گردآورندگان", RoleCompiler},
+	{"تدوین", RoleCompiler}, {"انتخاب", RoleCompiler}, {"گزینش", RoleCompiler},
+	{"راوی", RoleNarrator}, {"به روایت", RoleNarrator}, {"گوینده", RoleNarrator},
+	{"عکاس", RolePhotographer}, {"عکس", RolePhotographer}, {"عکس‌ها", RolePhotographer},
+	{"مقدمه", RolePrefacer}, {"با مقدمه", RolePrefacer}, {"با مقدمه‌ی", RolePrefacer},
+	{"مقدمه‌نویس", RolePrefacer}, {"مقدمه نویس", RolePrefacer},
+	{"انطباق فرهنگی", RoleAdapter}, {"بازآفرینی", RoleAdapter}, {"بازنویسی", RoleAdapter},
+	{"بومی‌سازی", RoleAdapter}, {"تنظیم", RoleAdapter}, {"اقتباس", RoleAdapter},
+	{"شعرهای", RoleLyricist}, {"ترانه‌سرا", RoleLyricist}, {"ترانه سرا", RoleLyricist},
+	{"تحقیق", RoleResearcher}, {"پژوهش", RoleResearcher}, {"محقق", RoleResearcher},
+	{"شرح", RoleCommentator}, {"شارح", RoleCommentator},
+}
+
+// Contributors returns the people and bodies named in the statement of
+// responsibility (عنوان و نام پدیدآور) followed by those only found in the
+// added entries (شناسه افزوده). A contributor with more than one role is
+// listed once for each.
+func (b *Book) Contributors() []Contributor {
+	contributors := make([]Contributor, 0)
+	if text := b.fields.Get(LabelTitle); text != "" {
+		contributors = append(contributors, b.contributorsFromField(text)...)
+	}
+
+	for _, e := range b.AddedEntries() {
+		roles := e.Roles
+		if len(roles) == 0 {
+			roles = []Role{RoleContributor}
+		}
+		for _, role := range roles {
+			if c := (Contributor{Name: e.Name, Role: role}); !hasContributor(contributors, c) {
+				contributors = append(contributors, c)
+			}
+		}
+	}
+
+	return contributors
+}
+
+// contributorsFromField reads the statement of responsibility after the
+// last "/" of a title field. Its clauses are separated by "؛" and start
+// with the roles of the names that follow; names in the first clause with
+// no role are authors.
+func (b *Book) contributorsFromField(text string) []Contributor {
+	contributors := make([]Contributor, 0)
+
+	i := strings.LastIndex(text, "/")
+	if i < 0 {
+		return contributors
+	}
+
+	for n, clause := range strings.Split(clean(text[i+1:]), "؛") {
+		roles, names := splitRoles(trimConnective(strings.TrimSpace(clause)))
+		if len(roles) == 0 {
+			roles = []Role{RoleContributor}
+			if n == 0 {
+				roles = []Role{RoleAuthor}
+			}
+		}
+
+		for _, name := range splitNames(names) {
+			for _, role := range roles {
+				contributors = append(contributors, Contributor{Name: name, Role: role})
+			}
+		}
+	}
+
+	return contributors
+}
+
+// splitRoles splits the roles off the start of a clause like "ترجمه و
+// شعرهای مصطفی رحماندوست" and returns them with the rest of the clause.
+// Words between the roles and an "از", as in "برگردان به نثر از مریم
+// کریمی", are dropped with it, as is the language of "ترجمه از عربی".
+func splitRoles(s string) ([]Role, string) {
+	roles := make([]Role, 0)
+	for {
+		role, rest, ok := matchRole(strings.TrimLeft(s, "[( "))
+		if !ok {
+			break
+		}
+		roles = append(roles, role)
+		s = strings.TrimLeft(rest, "]) ")
+
+		if rest := strings.TrimPrefix(s, "و "); rest != s {
+			if _, _, ok := matchRole(strings.TrimLeft(rest, "[( ")); ok {
+				s = rest
+			}
+		}
+	}
+
+	if len(roles) > 0 {
+		words := strings.Fields(s)
+		from := -1
+		for i := 0; i < len(words) && i < 5 && !strings.ContainsAny(words[i], "،,"); i++ {
+			if strings.Trim(words[i], "[]") == "از" {
+				from = i
+			}
+		}
+		if from >= 0 {
+			words = words[from+1:]
+			if len(words) > 1 && languageCode(words[0]) != "" {
+				words = words[1:]
+			}
+			s = strings.Join(words, " ")
+		}
+	}
+
+	return roles, trimConnective(s)
+}
+
+// trimConnective trims a leading "از" of a clause, bracketed or not, as in
+// "[از] شاعری ناشناس".
+func trimConnective(s string) string {
+	for _, prefix := range []string{"[از]", "از "} {
+		s = strings.TrimSpace(strings.TrimPrefix(s, prefix))
+	}
+
+	return s
+}
+
+// matchRole matches the longest role word s starts with.
+func matchRole(s string) (role Role, rest string, ok bool) {
+	n := 0
+	for _, rw := range roleWords {
+		if len(rw.word) <= n || !strings.HasPrefix(s, rw.word) {
+			continue
+		}
+		if next, _ := utf8.DecodeRuneInString(s[len(rw.word):]); next != utf8.RuneError && !strings.ContainsRune(" ]):", next) {
+			continue
+		}
+		role, n, ok = rw.role, len(rw.word), true
+	}
+
+	return role, strings.TrimLeft(s[n:], ": "), ok
+}
+
+func splitNames(s string) []string {
+	s = strings.ReplaceAll(s, " و ", "،")
+	s = strings.ReplaceAll(s, ",", "،")
+
+	names := make([]string, 0)
+	for _, name := range strings.Split(s, "،") {
+		if name = strings.Trim(name, " .[]()"); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// hasContributor reports whether c is already in contributors. Names are
+// compared ignoring spaces, so the direct and inverted forms of a name in
+// the title and the added entries match, and a contributor with no known
+// role matches one with any role.
+func hasContributor(contributors []Contributor, c Contributor) bool {
+	key := nameKey(c.Name)
+	for _, other := range contributors {
+		if nameKey(other.Name) == key && (other.Role == c.Role || c.Role == RoleContributor) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func nameKey(name string) string {
+	return strings.NewReplacer(" ", "", "\u200c", "", "-", "", ".", "").Replace(name)
+}
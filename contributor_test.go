@@ -0,0 +1,107 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestContributorsFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  []Contributor
+	}{
+		{
+			"‏سمفونی مردگان/ عباس معروفی.",
+			[]Contributor{{"عباس معروفی", RoleAuthor}},
+		},
+		{
+			"‏سیاست/ اندرو هی‌وود؛ ترجمه مجتبی مقصودی، الهه علوی، مسعود جوادیان.",
+			[]Contributor{
+				{"اندرو هی‌وود", RoleAuthor},
+				{"مجتبی مقصودی", RoleTranslator},
+				{"الهه علوی", RoleTranslator},
+				{"مسعود جوادیان", RoleTranslator},
+			},
+		},
+		{
+			"‏گروفالو/ جولیا دونالدسون؛ تصویرگر اکسل شفلر؛ ترجمه و بازآفرینی آتوسا صالحی.",
+			[]Contributor{
+				{"جولیا دونالدسون", RoleAuthor},
+				{"اکسل شفلر", RoleIllustrator},
+				{"آتوسا صالحی", RoleTranslator},
+				{"آتوسا صالحی", RoleAdapter},
+			},
+		},
+		{
+			"‏اعجوبه/ آر. جی. پالاسیو؛ [ترجمه] هدا نژادحسینیان.",
+			[]Contributor{{"آر. جی. پالاسیو", RoleAuthor}, {"هدا نژادحسینیان", RoleTranslator}},
+		},
+		{
+			"‏بیگانه/ آلبر کامو؛ ترجمه‌ی محمد عباس‌آبادی.",
+			[]Contributor{{"آلبر کامو", RoleAuthor}, {"محمد عباس‌آبادی", RoleTranslator}},
+		},
+		{
+			"‏دیوان حافظ/ به کوشش مسعود فرزاد.",
+			[]Contributor{{"مسعود فرزاد", RoleEditor}},
+		},
+		{
+			"‏منیه‌المرید فی ادب المفید و المستفید/ زین‌الدین‌بن علی شهیدثانی؛ تحقیق رضا مختاری.",
+			[]Contributor{{"زین‌الدین‌بن علی شهیدثانی", RoleAuthor}, {"رضا مختاری", RoleResearcher}},
+		},
+		{
+			"‏کتاب عکس/ عکس‌ها علی رضایی؛ با مقدمه‌ی مریم کریمی.",
+			[]Contributor{{"علی رضایی", RolePhotographer}, {"مریم کریمی", RolePrefacer}},
+		},
+		{
+			"‏دیوان/ [از] شاعری ناشناس؛ برگردان به نثر از مریم کریمی.",
+			[]Contributor{{"شاعری ناشناس", RoleAuthor}, {"مریم کریمی", RoleTranslator}},
+		},
+		{
+			"‏رباعیات/ [نوشته] عمر خیام؛ ترجمه از متن انگلیسی از احمد سعیدی.",
+			[]Contributor{{"عمر خیام", RoleAuthor}, {"احمد سعیدی", RoleTranslator}},
+		},
+		{
+			"‏کلیله و دمنه/ ترجمه از عربی نصرالله منشی.",
+			[]Contributor{{"نصرالله منشی", RoleTranslator}},
+		},
+		{
+			"‏بدون پدیدآور",
+			[]Contributor{},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if contributors := b.contributorsFromField(test.text); !reflect.DeepEqual(contributors, test.exp) {
+			t.Errorf("Test %d: Expected contributors %v, but got %v", i, test.exp, contributors)
+		}
+	}
+}
+
+func TestContributors(t *testing.T) {
+	tests := []struct {
+		url string
+		exp []Contributor
+	}{
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/1070294",
+			[]Contributor{{"محمود طلوع", RoleTranslator}, {"دانشگاه هاروارد", RoleContributor}},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/5481844",
+			[]Contributor{{"میشل اوباما", RoleAuthor}, {"الهام رعایی", RoleTranslator}},
+		},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if contributors := book.Contributors(); !reflect.DeepEqual(contributors, test.exp) {
+			t.Errorf("Test %d: Expected contributors %v, but got %v", i, test.exp, contributors)
+		}
+	}
+}
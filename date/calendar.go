@@ -0,0 +1,175 @@
+// Package date converts between the Solar Hijri (Jalali), Gregorian and
+// lunar Hijri calendars and parses the years found in NLAI records.
+package date
+
+import (
+	"fmt"
+)
+
+// Calendar is the calendar a year is given in.
+type Calendar int
+
+const (
+	Unknown Calendar = iota
+	Jalali
+	Gregorian
+	Hijri
+)
+
+var calendarNames = [...]string{"unknown", "jalali", "gregorian", "hijri"}
+
+func (c Calendar) String() string {
+	if c < 0 || int(c) >= len(calendarNames) {
+		return fmt.Sprintf("Calendar(%d)", int(c))
+	}
+
+	return calendarNames[c]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Calendar) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Calendar) UnmarshalText(text []byte) error {
+	for i, name := range calendarNames {
+		if string(text) == name {
+			*c = Calendar(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("date: unknown calendar %q", text)
+}
+
+// Jalali leap years follow the 33 year cycles starting at these years.
+var jalaliBreaks = [...]int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181,
+	1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// jalaliCal returns the Gregorian year jy starts in and the day of March
+// Farvardin 1st falls on.
+func jalaliCal(jy int) (gy, march int) {
+	gy = jy + 621
+	leapJ := -14
+	jp := jalaliBreaks[0]
+	jump := 0
+	for _, jm := range jalaliBreaks[1:] {
+		jump = jm - jp
+		if jy < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+	n := jy - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	return
+}
+
+// gregorianToJDN returns the Julian day number of a Gregorian date.
+func gregorianToJDN(gy, gm, gd int) int {
+	d := (gy+(gm-8)/6+100100)*1461/4 + (153*((gm+9)%12)+2)/5 + gd - 34840408
+	return d - (gy+100100+(gm-8)/6)/100*3/4 + 752
+}
+
+func jdnToGregorian(jdn int) (gy, gm, gd int) {
+	j := 4*jdn + 139361631
+	j += (4*jdn+183187720)/146097*3/4*4 - 3908
+	i := j%1461/4*5 + 308
+	gd = i%153/5 + 1
+	gm = i/153%12 + 1
+	gy = j/1461 - 100100 + (8-gm)/6
+
+	return
+}
+
+func jalaliToJDN(jy, jm, jd int) int {
+	gy, march := jalaliCal(jy)
+	return gregorianToJDN(gy, 3, march) + (jm-1)*31 - jm/7*(jm-7) + jd - 1
+}
+
+func jdnToJalali(jdn int) (jy, jm, jd int) {
+	gy, _, _ := jdnToGregorian(jdn)
+	jy = gy - 621
+	_, march := jalaliCal(jy)
+	k := jdn - gregorianToJDN(gy, 3, march)
+	if k >= 0 {
+		if k <= 185 {
+			return jy, 1 + k/31, k%31 + 1
+		}
+		k -= 186
+	} else {
+		jy--
+		k += 179
+		if isJalaliLeap(jy) {
+			k++
+		}
+	}
+
+	return jy, 7 + k/30, k%30 + 1
+}
+
+func isJalaliLeap(jy int) bool {
+	return jalaliToJDN(jy+1, 1, 1)-jalaliToJDN(jy, 1, 1) == 366
+}
+
+// hijriEpoch is the Julian day number of 1 Muharram 1 AH in the tabular
+// Islamic calendar.
+const hijriEpoch = 1948440
+
+func hijriToJDN(hy, hm, hd int) int {
+	return hd + (59*(hm-1)+1)/2 + (hy-1)*354 + (3+11*hy)/30 + hijriEpoch - 1
+}
+
+func jdnToHijri(jdn int) (hy, hm, hd int) {
+	hy = (30*(jdn-hijriEpoch) + 10646) / 10631
+	hm = 1
+	for hm < 12 && jdn >= hijriToJDN(hy, hm+1, 1) {
+		hm++
+	}
+	hd = jdn - hijriToJDN(hy, hm, 1) + 1
+
+	return
+}
+
+// JalaliToGregorian converts a Solar Hijri date to the Gregorian calendar.
+func JalaliToGregorian(jy, jm, jd int) (gy, gm, gd int) {
+	return jdnToGregorian(jalaliToJDN(jy, jm, jd))
+}
+
+// GregorianToJalali converts a Gregorian date to the Solar Hijri calendar.
+func GregorianToJalali(gy, gm, gd int) (jy, jm, jd int) {
+	return jdnToJalali(gregorianToJDN(gy, gm, gd))
+}
+
+// HijriToGregorian converts a lunar Hijri date to the Gregorian calendar.
+// The lunar calendar is the arithmetical (tabular) one, so dates may be a day
+// or two off the observed calendar.
+func HijriToGregorian(hy, hm, hd int) (gy, gm, gd int) {
+	return jdnToGregorian(hijriToJDN(hy, hm, hd))
+}
+
+// GregorianToHijri converts a Gregorian date to the tabular lunar Hijri
+// calendar.
+func GregorianToHijri(gy, gm, gd int) (hy, hm, hd int) {
+	return jdnToHijri(gregorianToJDN(gy, gm, gd))
+}
+
+// JalaliToHijri converts a Solar Hijri date to the tabular lunar Hijri
+// calendar.
+func JalaliToHijri(jy, jm, jd int) (hy, hm, hd int) {
+	return jdnToHijri(jalaliToJDN(jy, jm, jd))
+}
+
+// HijriToJalali converts a tabular lunar Hijri date to the Solar Hijri
+// calendar.
+func HijriToJalali(hy, hm, hd int) (jy, jm, jd int) {
+	return jdnToJalali(hijriToJDN(hy, hm, hd))
+}
@@ -0,0 +1,62 @@
+package date
+
+import "testing"
+
+func TestJalaliGregorian(t *testing.T) {
+	tests := []struct {
+		jy, jm, jd int
+		gy, gm, gd int
+	}{
+		{1398, 1, 1, 2019, 3, 21},
+		{1403, 1, 1, 2024, 3, 20},
+		{1399, 12, 30, 2021, 3, 20},
+		{1357, 11, 22, 1979, 2, 11},
+		{1386, 7, 1, 2007, 9, 23},
+		{1, 1, 1, 622, 3, 22},
+	}
+
+	for i, test := range tests {
+		if gy, gm, gd := JalaliToGregorian(test.jy, test.jm, test.jd); gy != test.gy || gm != test.gm || gd != test.gd {
+			t.Errorf("Test %d: Expected %d/%d/%d, but got %d/%d/%d",
+				i, test.gy, test.gm, test.gd, gy, gm, gd)
+		}
+		if jy, jm, jd := GregorianToJalali(test.gy, test.gm, test.gd); jy != test.jy || jm != test.jm || jd != test.jd {
+			t.Errorf("Test %d: Expected %d/%d/%d, but got %d/%d/%d",
+				i, test.jy, test.jm, test.jd, jy, jm, jd)
+		}
+	}
+}
+
+// The expected dates are those of the tabular calendar, which can be a day
+// off the observed one.
+func TestHijriGregorian(t *testing.T) {
+	tests := []struct {
+		hy, hm, hd int
+		gy, gm, gd int
+	}{
+		{1, 1, 1, 622, 7, 19},
+		{1440, 1, 1, 2018, 9, 12},
+		{1445, 1, 1, 2023, 7, 19},
+		{1435, 9, 1, 2014, 6, 29},
+	}
+
+	for i, test := range tests {
+		if gy, gm, gd := HijriToGregorian(test.hy, test.hm, test.hd); gy != test.gy || gm != test.gm || gd != test.gd {
+			t.Errorf("Test %d: Expected %d/%d/%d, but got %d/%d/%d",
+				i, test.gy, test.gm, test.gd, gy, gm, gd)
+		}
+		if hy, hm, hd := GregorianToHijri(test.gy, test.gm, test.gd); hy != test.hy || hm != test.hm || hd != test.hd {
+			t.Errorf("Test %d: Expected %d/%d/%d, but got %d/%d/%d",
+				i, test.hy, test.hm, test.hd, hy, hm, hd)
+		}
+	}
+}
+
+func TestJalaliHijri(t *testing.T) {
+	if hy, hm, hd := JalaliToHijri(1397, 6, 21); hy != 1440 || hm != 1 || hd != 1 {
+		t.Errorf("Expected 1440/1/1, but got %d/%d/%d", hy, hm, hd)
+	}
+	if jy, jm, jd := HijriToJalali(1440, 1, 1); jy != 1397 || jm != 6 || jd != 21 {
+		t.Errorf("Expected 1397/6/21, but got %d/%d/%d", jy, jm, jd)
+	}
+}
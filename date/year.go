@@ -0,0 +1,182 @@
+package date
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// ErrNoYear is returned by ParseYear when its input has no year.
+var ErrNoYear = errors.New("date: no year found")
+
+// Year is a year as given in a record. Bracketed years were supplied by the
+// cataloger rather than taken from the book, uncertain ones are followed by
+// a question mark and open ones start a range with no end, like the years
+// of a multi volume work still being published.
+type Year struct {
+	Value     int      `json:"value"`
+	Calendar  Calendar `json:"calendar"`
+	Bracketed bool     `json:"bracketed,omitempty"`
+	Uncertain bool     `json:"uncertain,omitempty"`
+	Open      bool     `json:"open,omitempty"`
+}
+
+var reYear = regexp.MustCompile(`(\[)?\s*(?:c|©)?\s*(\d{3,4})\s*([؟?])?\s*(ق\.?\s*م|ه\.?\s*ق|ه\.?\s*ش|ق|م|ش)?\.?\s*([؟?])?\s*(\])?\s*(-)?`)
+
+// reHijriPlace matches the Arab cities whose books are dated in the lunar
+// Hijri calendar, often without a mark.
+var reHijriPlace = regexp.MustCompile(`(?:^|[^\p{L}\x{200c}])(?:ال)?(?:بیروت|قاهره|دمشق|بغداد|نجف|کربلا|ریاض|کویت)(?:[^\p{L}\x{200c}]|$)`)
+
+// hijriCue reports whether s names an Arab city or is written in Arabic,
+// told by the letters "ة", "ى", "أ" and "إ" that Persian doesn't use.
+func hijriCue(s string) bool {
+	if strings.ContainsAny(s, "ةىأإ") {
+		return true
+	}
+
+	return reHijriPlace.MatchString(strings.NewReplacer("ي", "ی", "ك", "ک").Replace(s))
+}
+
+// ParseYear parses the first year found in s, e.g. "۱۳۹۸", "[۱۳۹۸؟]",
+// "۱۳۹۸-", "۱۴۳۵ق." or "c2019". Years without a calendar mark are taken
+// as Gregorian from 1500 on, as lunar Hijri if s names an Arab city or is
+// written in Arabic, as in "بیروت: دار الکتب، ۱۴۲۰", and as Solar Hijri
+// otherwise. Years before Christ ("ق.م.") are negative Gregorian years.
+func ParseYear(s string) (Year, error) {
+	years := ParseYears(s)
+	if len(years) == 0 {
+		return Year{}, ErrNoYear
+	}
+
+	return years[0], nil
+}
+
+// ParseYears parses every year found in s, as in "۱۴۳۵ق. = ۱۳۹۳" or
+// "۱۳۹۸ [۲۰۱۹م.]". The calendar mark of the end of a range like
+// "۷۲۷-۷۹۲ق." applies to its start too. See ParseYear.
+func ParseYears(s string) []Year {
+	return parseYears(s, hijriCue(s))
+}
+
+// ParseImprintYears parses the years of pubDate, the date of a publication
+// statement, taking unmarked years as lunar Hijri if its place and
+// publisher, imprint, name an Arab city or are written in Arabic. See
+// ParseYear.
+func ParseImprintYears(imprint, pubDate string) []Year {
+	return parseYears(pubDate, hijriCue(imprint) || hijriCue(pubDate))
+}
+
+func parseYears(s string, hijri bool) []Year {
+	s = persian.Digits(s)
+
+	years := make([]Year, 0)
+	marked := make([]bool, 0)
+	rangeStart := false
+	for _, m := range reYear.FindAllStringSubmatchIndex(s, -1) {
+		group := func(i int) string {
+			if m[2*i] < 0 {
+				return ""
+			}
+			return s[m[2*i]:m[2*i+1]]
+		}
+
+		y := Year{
+			Bracketed: group(1) != "" || group(6) != "",
+			Uncertain: group(3) != "" || group(5) != "",
+		}
+		y.Value, _ = strconv.Atoi(group(2))
+
+		mark := group(4)
+		switch {
+		case strings.HasSuffix(mark, "م") && strings.HasPrefix(mark, "ق"):
+			y.Calendar = Gregorian
+			y.Value = -y.Value
+		case strings.HasSuffix(mark, "ق"):
+			y.Calendar = Hijri
+		case mark == "م":
+			y.Calendar = Gregorian
+		case strings.HasSuffix(mark, "ش"):
+			y.Calendar = Jalali
+		case y.Value >= 1500:
+			y.Calendar = Gregorian
+		case hijri:
+			y.Calendar = Hijri
+		default:
+			y.Calendar = Jalali
+		}
+
+		n := len(years)
+		if rangeStart && mark != "" && !marked[n-1] {
+			years[n-1].Calendar = y.Calendar
+			if y.Value < 0 {
+				years[n-1].Value = -years[n-1].Value
+			}
+		}
+
+		// A dash followed by another year is a closed range, not an open one.
+		rangeStart = false
+		if group(7) != "" {
+			rest := strings.TrimSpace(s[m[1]:])
+			y.Open = rest == "" || rest[0] < '0' || rest[0] > '9'
+			rangeStart = !y.Open
+		}
+
+		years = append(years, y)
+		marked = append(marked, mark != "")
+	}
+
+	return years
+}
+
+// In returns the year converted to calendar c. As a year of one calendar
+// spans two years of the others, the year the middle of y falls in is
+// returned.
+func (y Year) In(c Calendar) int {
+	if y.Calendar == c || y.Value == 0 {
+		return y.Value
+	}
+
+	var jdn int
+	switch y.Calendar {
+	case Jalali:
+		jdn = jalaliToJDN(y.Value, 7, 1)
+	case Gregorian:
+		jdn = gregorianToJDN(y.Value, 7, 1)
+	case Hijri:
+		jdn = hijriToJDN(y.Value, 7, 1)
+	default:
+		return 0
+	}
+
+	switch c {
+	case Jalali:
+		jy, _, _ := jdnToJalali(jdn)
+		return jy
+	case Gregorian:
+		gy, _, _ := jdnToGregorian(jdn)
+		return gy
+	case Hijri:
+		hy, _, _ := jdnToHijri(jdn)
+		return hy
+	}
+
+	return 0
+}
+
+// Jalali returns the year in the Solar Hijri calendar.
+func (y Year) Jalali() int {
+	return y.In(Jalali)
+}
+
+// Gregorian returns the year in the Gregorian calendar.
+func (y Year) Gregorian() int {
+	return y.In(Gregorian)
+}
+
+// Hijri returns the year in the lunar Hijri calendar.
+func (y Year) Hijri() int {
+	return y.In(Hijri)
+}
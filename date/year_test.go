@@ -0,0 +1,122 @@
+package date
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseYear(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp Year
+		err error
+	}{
+		{"۱۳۹۸", Year{Value: 1398, Calendar: Jalali}, nil},
+		{"۱۳۹۸.", Year{Value: 1398, Calendar: Jalali}, nil},
+		{"[۱۳۹۸]", Year{Value: 1398, Calendar: Jalali, Bracketed: true}, nil},
+		{"[۱۳۹۸؟]", Year{Value: 1398, Calendar: Jalali, Bracketed: true, Uncertain: true}, nil},
+		{"۱۳۹۸-", Year{Value: 1398, Calendar: Jalali, Open: true}, nil},
+		{"۱۳۸۹ - ۱۳۹۲.", Year{Value: 1389, Calendar: Jalali}, nil},
+		{"۱۴۳۵ق.", Year{Value: 1435, Calendar: Hijri}, nil},
+		{"۱۴۰۶", Year{Value: 1406, Calendar: Jalali}, nil},
+		{"تهران: نجفی، ۱۴۲۰.", Year{Value: 1420, Calendar: Jalali}, nil},
+		{"بیروت: دار الکتب، ۱۴۲۰.", Year{Value: 1420, Calendar: Hijri}, nil},
+		{"النجف الاشرف: المکتبة الحیدریة، ۱۳۸۵", Year{Value: 1385, Calendar: Hijri}, nil},
+		{"٢٠١٩م.", Year{Value: 2019, Calendar: Gregorian}, nil},
+		{"c2018", Year{Value: 2018, Calendar: Gregorian}, nil},
+		{"۱۹۶۵ - م.", Year{Value: 1965, Calendar: Gregorian, Open: true}, nil},
+		{"۳۴۷ ق.م.", Year{Value: -347, Calendar: Gregorian}, nil},
+		{"[بی‌تا]", Year{}, ErrNoYear},
+	}
+
+	for i, test := range tests {
+		y, err := ParseYear(test.s)
+		if err != test.err {
+			t.Errorf("Test %d: Expected error %v, but got %v", i, test.err, err)
+		}
+		if y != test.exp {
+			t.Errorf("Test %d: Expected year %+v, but got %+v", i, test.exp, y)
+		}
+	}
+}
+
+func TestParseYears(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp []Year
+	}{
+		{
+			"۱۴۳۵ق. = ۱۳۹۳.",
+			[]Year{{Value: 1435, Calendar: Hijri}, {Value: 1393, Calendar: Jalali}},
+		},
+		{
+			"۱۳۹۸ [۲۰۱۹م.]",
+			[]Year{{Value: 1398, Calendar: Jalali}, {Value: 2019, Calendar: Gregorian, Bracketed: true}},
+		},
+		{
+			"۷۲۷-۷۹۲ق.",
+			[]Year{{Value: 727, Calendar: Hijri}, {Value: 792, Calendar: Hijri}},
+		},
+		{
+			"۴۲۷؟ - ۳۴۷ ق.م.",
+			[]Year{{Value: -427, Calendar: Gregorian, Uncertain: true}, {Value: -347, Calendar: Gregorian}},
+		},
+		{
+			"۶۲۰؟-۵۶۰؟ ق.م.",
+			[]Year{{Value: -620, Calendar: Gregorian, Uncertain: true}, {Value: -560, Calendar: Gregorian, Uncertain: true}},
+		},
+		{
+			"۱۳۸۹ - ۱۳۹۲",
+			[]Year{{Value: 1389, Calendar: Jalali}, {Value: 1392, Calendar: Jalali}},
+		},
+		{
+			"",
+			[]Year{},
+		},
+	}
+
+	for i, test := range tests {
+		if years := ParseYears(test.s); !reflect.DeepEqual(years, test.exp) {
+			t.Errorf("Test %d: Expected years %+v, but got %+v", i, test.exp, years)
+		}
+	}
+}
+
+func TestParseImprintYears(t *testing.T) {
+	tests := []struct {
+		imprint, pubDate string
+		exp              []Year
+	}{
+		{"تهران: ققنوس", "۱۴۰۶.", []Year{{Value: 1406, Calendar: Jalali}}},
+		{"بیروت: دار الکتب العلمیة", "۱۴۲۰.", []Year{{Value: 1420, Calendar: Hijri}}},
+		{"قم: دار الحدیث", "۱۴۲۰ق. = ۱۳۷۸.", []Year{{Value: 1420, Calendar: Hijri}, {Value: 1378, Calendar: Jalali}}},
+		{"القاهرة: مکتبة الخانجی", "۲۰۰۱.", []Year{{Value: 2001, Calendar: Gregorian}}},
+	}
+
+	for i, test := range tests {
+		if years := ParseImprintYears(test.imprint, test.pubDate); !reflect.DeepEqual(years, test.exp) {
+			t.Errorf("Test %d: Expected years %+v, but got %+v", i, test.exp, years)
+		}
+	}
+}
+
+func TestYearIn(t *testing.T) {
+	tests := []struct {
+		y         Year
+		jalali    int
+		gregorian int
+		hijri     int
+	}{
+		{Year{Value: 1398, Calendar: Jalali}, 1398, 2019, 1441},
+		{Year{Value: 2019, Calendar: Gregorian}, 1398, 2019, 1440},
+		{Year{Value: 1435, Calendar: Hijri}, 1393, 2014, 1435},
+		{Year{}, 0, 0, 0},
+	}
+
+	for i, test := range tests {
+		if j, g, h := test.y.Jalali(), test.y.Gregorian(), test.y.Hijri(); j != test.jalali || g != test.gregorian || h != test.hijri {
+			t.Errorf("Test %d: Expected (%d, %d, %d), but got (%d, %d, %d)",
+				i, test.jalali, test.gregorian, test.hijri, j, g, h)
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package melli
+
+import (
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// DeweyCategory is a class or a division of the Dewey Decimal
+// Classification.
+type DeweyCategory struct {
+	Code string `json:"code"`
+	Fa   string `json:"fa"`
+	En   string `json:"en"`
+}
+
+var deweyClasses = [10]DeweyCategory{
+	{"000", "علوم رایانه، اطلاعات و آثار کلی", "Computer science, information & general works"},
+	{"100", "فلسفه و روان‌شناسی", "Philosophy & psychology"},
+	{"200", "دین", "Religion"},
+	{"300", "علوم اجتماعی", "Social sciences"},
+	{"400", "زبان", "Language"},
+	{"500", "علوم", "Science"},
+	{"600", "فناوری", "Technology"},
+	{"700", "هنرها و سرگرمی", "Arts & recreation"},
+	{"800", "ادبیات", "Literature"},
+	{"900", "تاریخ و جغرافیا", "History & geography"},
+}
+
+var deweyDivisions = [100]DeweyCategory{
+	{"000", "علوم رایانه، دانش و سیستم‌ها", "Computer science, knowledge & systems"},
+	{"010", "کتابشناسی‌ها", "Bibliographies"},
+	{"020", "کتابداری و اطلاع‌رسانی", "Library & information sciences"},
+	{"030", "دایره‌المعارف‌ها", "Encyclopedias & books of facts"},
+	{"040", "", ""},
+	{"050", "نشریات ادواری", "Magazines, journals & serials"},
+	{"060", "انجمن‌ها، سازمان‌ها و موزه‌ها", "Associations, organizations & museums"},
+	{"070", "رسانه‌های خبری، روزنامه‌نگاری و نشر", "News media, journalism & publishing"},
+	{"080", "گزین‌گویه‌ها", "Quotations"},
+	{"090", "نسخه‌های خطی و کتاب‌های نادر", "Manuscripts & rare books"},
+	{"100", "فلسفه", "Philosophy"},
+	{"110", "مابعدالطبیعه", "Metaphysics"},
+	{"120", "معرفت‌شناسی", "Epistemology"},
+	{"130", "فراروان‌شناسی و علوم خفیه", "Parapsychology & occultism"},
+	{"140", "مکاتب فلسفی", "Philosophical schools of thought"},
+	{"150", "روان‌شناسی", "Psychology"},
+	{"160", "منطق", "Philosophical logic"},
+	{"170", "اخلاق", "Ethics"},
+	{"180", "فلسفه باستان، قرون وسطی و شرق", "Ancient, medieval & eastern philosophy"},
+	{"190", "فلسفه جدید غرب", "Modern western philosophy"},
+	{"200", "دین", "Religion"},
+	{"210", "فلسفه و نظریه دین", "Philosophy & theory of religion"},
+	{"220", "کتاب مقدس", "The Bible"},
+	{"230", "مسیحیت", "Christianity"},
+	{"240", "اعمال و آداب مسیحی", "Christian practice & observance"},
+	{"250", "کلیسا و فرقه‌های مذهبی مسیحی", "Christian pastoral practice & religious orders"},
+	{"260", "سازمان، خدمات اجتماعی و عبادت مسیحی", "Christian organization, social work & worship"},
+	{"270", "تاریخ مسیحیت", "History of Christianity"},
+	{"280", "فرقه‌های مسیحی", "Christian denominations"},
+	{"290", "ادیان دیگر", "Other religions"},
+	{"300", "علوم اجتماعی، جامعه‌شناسی و انسان‌شناسی", "Social sciences, sociology & anthropology"},
+	{"310", "آمار", "Statistics"},
+	{"320", "علوم سیاسی", "Political science"},
+	{"330", "اقتصاد", "Economics"},
+	{"340", "حقوق", "Law"},
+	{"350", "مدیریت دولتی و علوم نظامی", "Public administration & military science"},
+	{"360", "مسائل و خدمات اجتماعی", "Social problems & social services"},
+	{"370", "آموزش و پرورش", "Education"},
+	{"380", "بازرگانی، ارتباطات و حمل و نقل", "Commerce, communications & transportation"},
+	{"390", "آداب و رسوم و فرهنگ عامه", "Customs, etiquette & folklore"},
+	{"400", "زبان", "Language"},
+	{"410", "زبان‌شناسی", "Linguistics"},
+	{"420", "زبان انگلیسی", "English & Old English languages"},
+	{"430", "زبان آلمانی", "German & related languages"},
+	{"440", "زبان فرانسوی", "French & related languages"},
+	{"450", "زبان‌های ایتالیایی و رومانیایی", "Italian, Romanian & related languages"},
+	{"460", "زبان‌های اسپانیایی و پرتغالی", "Spanish, Portuguese, Galician"},
+	{"470", "زبان لاتین", "Latin & Italic languages"},
+	{"480", "زبان یونانی", "Classical & modern Greek languages"},
+	{"490", "زبان‌های دیگر", "Other languages"},
+	{"500", "علوم", "Science"},
+	{"510", "ریاضیات", "Mathematics"},
+	{"520", "نجوم", "Astronomy"},
+	{"530", "فیزیک", "Physics"},
+	{"540", "شیمی", "Chemistry"},
+	{"550", "علوم زمین و زمین‌شناسی", "Earth sciences & geology"},
+	{"560", "دیرین‌شناسی", "Fossils & prehistoric life"},
+	{"570", "زیست‌شناسی", "Biology"},
+	{"580", "گیاه‌شناسی", "Plants (Botany)"},
+	{"590", "جانورشناسی", "Animals (Zoology)"},
+	{"600", "فناوری", "Technology"},
+	{"610", "پزشکی و بهداشت", "Medicine & health"},
+	{"620", "مهندسی", "Engineering"},
+	{"630", "کشاورزی", "Agriculture"},
+	{"640", "مدیریت خانه و خانواده", "Home & family management"},
+	{"650", "مدیریت و روابط عمومی", "Management & public relations"},
+	{"660", "مهندسی شیمی", "Chemical engineering"},
+	{"670", "تولید صنعتی", "Manufacturing"},
+	{"680", "تولید برای کاربردهای خاص", "Manufacture for specific uses"},
+	{"690", "ساختمان‌سازی", "Construction of buildings"},
+	{"700", "هنرها", "Arts"},
+	{"710", "شهرسازی و معماری منظر", "Area planning & landscape architecture"},
+	{"720", "معماری", "Architecture"},
+	{"730", "مجسمه‌سازی، سرامیک و فلزکاری", "Sculpture, ceramics & metalwork"},
+	{"740", "طراحی و هنرهای تزئینی", "Graphic arts & decorative arts"},
+	{"750", "نقاشی", "Painting"},
+	{"760", "باسمه‌سازی و چاپ دستی", "Printmaking & prints"},
+	{"770", "عکاسی، هنر رایانه‌ای، فیلم و ویدئو", "Photography, computer art, film, video"},
+	{"780", "موسیقی", "Music"},
+	{"790", "ورزش، بازی‌ها و سرگرمی", "Sports, games & entertainment"},
+	{"800", "ادبیات، بلاغت و نقد", "Literature, rhetoric & criticism"},
+	{"810", "ادبیات آمریکایی", "American literature in English"},
+	{"820", "ادبیات انگلیسی", "English & Old English literatures"},
+	{"830", "ادبیات آلمانی", "German & related literatures"},
+	{"840", "ادبیات فرانسوی", "French & related literatures"},
+	{"850", "ادبیات ایتالیایی و رومانیایی", "Italian, Romanian & related literatures"},
+	{"860", "ادبیات اسپانیایی و پرتغالی", "Spanish, Portuguese, Galician literatures"},
+	{"870", "ادبیات لاتین", "Latin & Italic literatures"},
+	{"880", "ادبیات یونانی", "Classical & modern Greek literatures"},
+	{"890", "ادبیات دیگر", "Other literatures"},
+	{"900", "تاریخ", "History"},
+	{"910", "جغرافیا و سفر", "Geography & travel"},
+	{"920", "سرگذشتنامه و تبارشناسی", "Biography & genealogy"},
+	{"930", "تاریخ جهان باستان", "History of ancient world"},
+	{"940", "تاریخ اروپا", "History of Europe"},
+	{"950", "تاریخ آسیا", "History of Asia"},
+	{"960", "تاریخ آفریقا", "History of Africa"},
+	{"970", "تاریخ آمریکای شمالی", "History of North America"},
+	{"980", "تاریخ آمریکای جنوبی", "History of South America"},
+	{"990", "تاریخ سایر مناطق", "History of other areas"},
+}
+
+// NLAI classes Persian literature under its own 8فا notation in place of
+// 891.55.
+var deweyPersianLiterature = DeweyCategory{"8فا", "ادبیات فارسی", "Persian literature"}
+
+// DeweyCategories returns the class and the division a Dewey number belongs
+// to. The number may use Persian digits and the Persian decimal slash. ok is
+// false if number doesn't fall in an assigned division.
+func DeweyCategories(number string) (class, division DeweyCategory, ok bool) {
+	number = strings.TrimSpace(persian.Digits(number))
+	if len(number) < 2 || number[0] < '0' || number[0] > '9' {
+		return
+	}
+	class = deweyClasses[number[0]-'0']
+
+	if strings.HasPrefix(number, "8فا") {
+		return class, deweyPersianLiterature, true
+	}
+	if number[1] < '0' || number[1] > '9' {
+		return
+	}
+	division = deweyDivisions[(number[0]-'0')*10+number[1]-'0']
+	if division.En == "" {
+		return
+	}
+
+	return class, division, true
+}
@@ -0,0 +1,113 @@
+package melli
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Label is the normalized label of a record page row.
+type Label string
+
+// Labels of the rows found on NLAI bibliographic record pages.
+const (
+	LabelMainEntry                  Label = "سرشناسه"
+	LabelTitle                      Label = "عنوان و نام پدیدآور"
+	LabelEdition                    Label = "وضعیت ویراست"
+	LabelPublication                Label = "مشخصات نشر"
+	LabelPhysical                   Label = "مشخصات ظاهری"
+	LabelSeries                     Label = "فروست"
+	LabelISBN                       Label = "شابک"
+	LabelCatalogingStatus           Label = "وضعیت فهرست نویسی"
+	LabelNote                       Label = "یادداشت"
+	LabelSubject                    Label = "موضوع"
+	LabelAddedEntry                 Label = "شناسه افزوده"
+	LabelLCC                        Label = "رده بندی کنگره"
+	LabelDewey                      Label = "رده بندی دیویی"
+	LabelNationalBibliographyNumber Label = "شماره کتابشناسی ملی"
+)
+
+// Fields is an ordered multimap of the labeled rows of a record page. Labels
+// are normalized, so variants with or without direction marks, Arabic or
+// Persian yeh and kaf, or a zero width non-joiner instead of a space are all
+// the same label.
+type Fields struct {
+	labels []Label
+	values map[Label][]string
+}
+
+var labelReplacer = strings.NewReplacer(
+	"\u200e", "", "\u200f", "", "\u202a", "", "\u202b", "", "\u202c", "",
+	"\u202d", "", "\u202e", "",
+	"ي", "ی", "ى", "ی", "ك", "ک",
+	"\u200c", " ", "\u00a0", " ",
+)
+
+func normalizeLabel(label string) Label {
+	label = labelReplacer.Replace(label)
+	label = strings.TrimSpace(label)
+	label = strings.TrimSuffix(label, ":")
+
+	return Label(strings.Join(strings.Fields(label), " "))
+}
+
+// parseFields indexes every row of doc whose label cell is followed by a
+// ":" cell and then the value cell.
+func parseFields(doc *goquery.Document) Fields {
+	f := Fields{values: make(map[Label][]string)}
+	doc.Find("td").Each(func(i int, sel *goquery.Selection) {
+		sep := sel.Next()
+		if strings.TrimSpace(sep.Text()) != ":" {
+			return
+		}
+		value := sep.Next()
+		if value.Length() == 0 {
+			return
+		}
+
+		f.add(sel.Text(), value.Text())
+	})
+
+	return f
+}
+
+func (f *Fields) add(text, value string) {
+	label := normalizeLabel(text)
+	if label == "" {
+		return
+	}
+	if _, exists := f.values[label]; !exists {
+		f.labels = append(f.labels, label)
+	}
+	f.values[label] = append(f.values[label], value)
+}
+
+// Labels returns the normalized labels in the order they first appear on
+// the page.
+func (f Fields) Labels() []Label {
+	return append([]Label(nil), f.labels...)
+}
+
+// Get returns the first value of label, or an empty string.
+func (f Fields) Get(label Label) string {
+	if values := f.values[normalizeLabel(string(label))]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// Values returns every value of label in page order.
+func (f Fields) Values(label Label) []string {
+	return append([]string(nil), f.values[normalizeLabel(string(label))]...)
+}
+
+// Map returns a copy of the fields keyed by their normalized labels.
+func (f Fields) Map() map[string][]string {
+	m := make(map[string][]string, len(f.values))
+	for label, values := range f.values {
+		m[string(label)] = append([]string(nil), values...)
+	}
+
+	return m
+}
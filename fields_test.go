@@ -0,0 +1,76 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeLabel(t *testing.T) {
+	tests := []struct {
+		label string
+		exp   Label
+	}{
+		{"‏سرشناسه", "سرشناسه"},
+		{"‏‏شابک", "شابک"},
+		{"‏عنوان و نام پديدآور", "عنوان و نام پدیدآور"},
+		{"يادداشت", "یادداشت"},
+		{" رده‌بندی  کنگره :", "رده بندی کنگره"},
+	}
+
+	for i, test := range tests {
+		if label := normalizeLabel(test.label); label != test.exp {
+			t.Errorf("Test %d: Expected label %q, but got %q", i, test.exp, label)
+		}
+	}
+}
+
+func TestFields(t *testing.T) {
+	book, err := testBook(bookURL("5481844"))
+	if err != nil {
+		t.Fatalf("Error on creating book: %s", err)
+	}
+	fields := book.Fields()
+
+	labels := []Label{LabelMainEntry, LabelTitle, LabelPublication, LabelPhysical,
+		LabelISBN, LabelCatalogingStatus, LabelNote, LabelSubject, LabelAddedEntry,
+		LabelLCC, LabelDewey, LabelNationalBibliographyNumber}
+	if l := fields.Labels(); !reflect.DeepEqual(l, labels) {
+		t.Errorf("Expected labels %q, but got %q", labels, l)
+	}
+
+	if n := len(fields.Values("‏يادداشت")); n != 3 {
+		t.Errorf("Expected 3 notes, but got %d", n)
+	}
+	if n := len(fields.Values("موضوع")); n != 4 {
+		t.Errorf("Expected 4 subjects, but got %d", n)
+	}
+	if v := fields.Get("‏‏شابک"); v != "‏978-600-7937-64-8: ۷۵۰۰۰۰ ریال" {
+		t.Errorf("Expected isbn field %q, but got %q", "‏978-600-7937-64-8: ۷۵۰۰۰۰ ریال", v)
+	}
+	if v := fields.Get("فروست"); v != "" {
+		t.Errorf("Expected no series field, but got %q", v)
+	}
+}
+
+func TestRawFields(t *testing.T) {
+	book, err := testBook(bookURL("2072242"))
+	if err != nil {
+		t.Fatalf("Error on creating book: %s", err)
+	}
+	fields := book.RawFields()
+
+	if n := len(fields); n != 12 {
+		t.Errorf("Expected 12 fields, but got %d", n)
+	}
+	if n := len(fields[string(LabelISBN)]); n != 3 {
+		t.Errorf("Expected 3 isbn rows, but got %d", n)
+	}
+	if v := fields[string(LabelNationalBibliographyNumber)]; len(v) != 1 || v[0] != "‏۲۰۷۲۲۴۲" {
+		t.Errorf("Expected national bibliography number %q, but got %q", "‏۲۰۷۲۲۴۲", v)
+	}
+
+	fields[string(LabelISBN)][0] = ""
+	if v := book.Fields().Get(LabelISBN); v == "" {
+		t.Error("Changing RawFields result changed the book fields")
+	}
+}
@@ -0,0 +1,127 @@
+package melli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Fixtures are saved bibliographic record pages under testdata/bibliographic,
+// named by their NLAI record id. To add one, create an empty <id>.html and
+// run the tests with -record. The pages checked in so far were written by
+// hand after NLAI pages, as the OPAC couldn't be reached when they were
+// added; re-record them and run -update to replace them with real ones.
+// Golden files under testdata/golden hold the Record of each fixture; run
+// with -update after a parser change to rewrite them, then review the diff.
+var (
+	record = flag.Bool("record", false, "re-download fixture pages from the NLAI OPAC")
+	update = flag.Bool("update", false, "rewrite golden files with the current Record output")
+)
+
+const fixtureDir = "testdata/bibliographic"
+
+// testBook parses the fixture of the record at url.
+func testBook(url string) (*Book, error) {
+	f, err := os.Open(filepath.Join(fixtureDir, path.Base(url)+".html"))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ParseBook(f, url)
+}
+
+func recordFixture(id string) error {
+	return savePage(bookURL(id), filepath.Join(fixtureDir, id+".html"))
+}
+
+// savePage writes the response body of u to file as received, so fixtures
+// are the pages NLAI serves rather than a re-rendering of them.
+func savePage(u, file string) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %q fetching %s", res.Status, u)
+	}
+
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, res.Body); err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+func bookURL(id string) string {
+	return "http://opac.nlai.ir/opac-prod/bibliographic/" + id
+}
+
+func TestGolden(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(fixtureDir, "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatalf("No fixtures found in %s", fixtureDir)
+	}
+
+	for _, file := range files {
+		id := strings.TrimSuffix(filepath.Base(file), ".html")
+		if *record {
+			if err := recordFixture(id); err != nil {
+				t.Errorf("Error on recording fixture %s: %s", id, err)
+				continue
+			}
+		}
+
+		book, err := testBook(bookURL(id))
+		if err != nil {
+			t.Errorf("Error on parsing fixture %s: %s", id, err)
+			continue
+		}
+		got, err := json.MarshalIndent(book.Record(), "", "\t")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, '\n')
+
+		goldenFile := filepath.Join("testdata", "golden", id+".json")
+		if *update {
+			if err := ioutil.WriteFile(goldenFile, got, 0644); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+
+		exp, err := ioutil.ReadFile(goldenFile)
+		if err != nil {
+			t.Errorf("Error on reading golden file of %s: %s", id, err)
+			continue
+		}
+		if !bytes.Equal(got, exp) {
+			t.Errorf("Fixture %s doesn't match its golden file, expected:\n%s\nbut got:\n%s",
+				id, exp, got)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Package persian has text helpers shared by the melli packages.
+package persian
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Digits replaces Persian and Arabic-Indic digits in s with ASCII ones.
+func Digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '۰' && r <= '۹':
+			return '0' + r - '۰'
+		case r >= '٠' && r <= '٩':
+			return '0' + r - '٠'
+		}
+		return r
+	}, s)
+}
+
+// Atoi is like strconv.Atoi but also accepts Persian and Arabic-Indic
+// digits.
+func Atoi(s string) (int, error) {
+	return strconv.Atoi(Digits(strings.TrimSpace(s)))
+}
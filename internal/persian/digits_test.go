@@ -0,0 +1,30 @@
+package persian
+
+import "testing"
+
+func TestDigits(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+	}{
+		{"۱۳۹۸", "1398"},
+		{"٢٠١٩", "2019"},
+		{"۲۱/۵×۱۴/۵ س م.", "21/5×14/5 س م."},
+		{"ISBN 978-600", "ISBN 978-600"},
+	}
+
+	for i, test := range tests {
+		if s := Digits(test.s); s != test.exp {
+			t.Errorf("Test %d: Expected %q, but got %q", i, test.exp, s)
+		}
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	if n, err := Atoi(" ۱۲۰۰۰ "); err != nil || n != 12000 {
+		t.Errorf("Expected 12000, but got %d, %v", n, err)
+	}
+	if _, err := Atoi("۱۲ص"); err == nil {
+		t.Error("Expected error on non numeric input")
+	}
+}
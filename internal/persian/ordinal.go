@@ -0,0 +1,44 @@
+package persian
+
+import (
+	"strings"
+)
+
+var (
+	ordinalUnits = map[string]int{
+		"اول": 1, "نخست": 1, "یکم": 1, "دوم": 2, "سوم": 3, "چهارم": 4,
+		"پنجم": 5, "ششم": 6, "هفتم": 7, "هشتم": 8, "نهم": 9, "دهم": 10,
+		"یازدهم": 11, "دوازدهم": 12, "سیزدهم": 13, "چهاردهم": 14,
+		"پانزدهم": 15, "شانزدهم": 16, "هفدهم": 17, "هجدهم": 18,
+		"هیجدهم": 18, "نوزدهم": 19, "بیستم": 20, "سی‌ام": 30, "سی ام": 30,
+		"چهلم": 40, "پنجاهم": 50,
+	}
+	tens = map[string]int{"بیست": 20, "سی": 30, "چهل": 40, "پنجاه": 50}
+)
+
+// Ordinal parses a Persian ordinal number written in words, like "دوم" or
+// "بیست و یکم", or in digits. ok is false if s isn't an ordinal.
+func Ordinal(s string) (n int, ok bool) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, "ي", "ی"))
+	if n, err := Atoi(s); err == nil {
+		return n, true
+	}
+	if n, ok := ordinalUnits[s]; ok {
+		return n, true
+	}
+
+	ss := strings.Split(s, " و ")
+	if len(ss) != 2 {
+		return 0, false
+	}
+	t, ok := tens[strings.TrimSpace(ss[0])]
+	if !ok {
+		return 0, false
+	}
+	u, ok := ordinalUnits[strings.TrimSpace(ss[1])]
+	if !ok || u >= 10 {
+		return 0, false
+	}
+
+	return t + u, true
+}
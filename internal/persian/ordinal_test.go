@@ -0,0 +1,27 @@
+package persian
+
+import "testing"
+
+func TestOrdinal(t *testing.T) {
+	tests := []struct {
+		s  string
+		n  int
+		ok bool
+	}{
+		{"اول", 1, true},
+		{"دوم", 2, true},
+		{"يازدهم", 11, true},
+		{"بیست و یکم", 21, true},
+		{"سی و دوم", 32, true},
+		{"۳", 3, true},
+		{"بیست و یازدهم", 0, false},
+		{"کتاب", 0, false},
+	}
+
+	for i, test := range tests {
+		if n, ok := Ordinal(test.s); n != test.n || ok != test.ok {
+			t.Errorf("Test %d: Expected (%d, %t), but got (%d, %t)",
+				i, test.n, test.ok, n, ok)
+		}
+	}
+}
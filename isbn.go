@@ -0,0 +1,114 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+	"github.com/ketabchi/melli/isbn"
+	"github.com/ketabchi/util"
+)
+
+// ISBN is one of the ISBNs (شابک) of a book. Qualifier says what the ISBN is
+// for, like "دوره" for the set or "ج. ۱" for a volume, and Volume is the
+// volume number read from it. ISBN13 and ISBN10 are set only when the check
+// digit is valid; Raw keeps the digits as given.
+type ISBN struct {
+	Raw       string `json:"raw"`
+	ISBN13    string `json:"isbn13,omitempty"`
+	ISBN10    string `json:"isbn10,omitempty"`
+	Valid     bool   `json:"valid"`
+	Qualifier string `json:"qualifier,omitempty"`
+	Volume    int    `json:"volume,omitempty"`
+	Price     Price  `json:"price"`
+}
+
+var (
+	reISBN       = regexp.MustCompile(`[0-9۰-۹٠-٩](?:-?[0-9۰-۹٠-٩]){8,12}(?:-?[Xx])?`)
+	reISBNVolume = regexp.MustCompile(`ج\s*\.?\s*([0-9۰-۹]+)`)
+	reISBNQual   = regexp.MustCompile(`\(([^)]*)\)`)
+	reCurrency   = regexp.MustCompile(`^\s*(?:ریال|ريال|تومان|ر\.|ت\.)`)
+)
+
+// ISBNs returns every ISBN given in the record, in record order. A row may
+// give more than one, like "978-964-311-326-1 (دوره)؛ 978-964-311-327-8
+// (ج. ۱)".
+func (b *Book) ISBNs() []ISBN {
+	isbns := make([]ISBN, 0)
+	for _, text := range b.fields.Values(LabelISBN) {
+		isbns = append(isbns, b.isbnEntriesFromField(text)...)
+	}
+
+	return isbns
+}
+
+// isbnEntriesFromField reads each ISBN of a row with the text around it.
+// The text between two ISBNs is split at its last "؛": what comes before
+// belongs to the first ISBN and what comes after to the second.
+func (b *Book) isbnEntriesFromField(text string) []ISBN {
+	text = clean(text)
+	entries := make([]ISBN, 0)
+
+	locs := make([][]int, 0)
+	for _, loc := range reISBN.FindAllStringIndex(text, -1) {
+		if isISBNMatch(text[loc[0]:loc[1]], text[loc[1]:]) {
+			locs = append(locs, loc)
+		}
+	}
+	start := 0
+	for i, loc := range locs {
+		end := len(text)
+		if i+1 < len(locs) {
+			end = loc[1]
+			if j := strings.LastIndex(text[loc[1]:locs[i+1][0]], "؛"); j >= 0 {
+				end += j
+			}
+		}
+		entries = append(entries, b.isbnEntryFromText(text[start:loc[0]], text[loc[0]:loc[1]], text[loc[1]:end]))
+		start = end
+	}
+
+	return entries
+}
+
+// isISBNMatch reports whether number, followed by rest, is an ISBN rather
+// than a price: prices are followed by their currency, and a number with no
+// hyphens is taken as an ISBN only if its check digit is valid.
+func isISBNMatch(number, rest string) bool {
+	if reCurrency.MatchString(rest) {
+		return false
+	}
+	if strings.Contains(number, "-") {
+		return true
+	}
+	_, err := isbn.ToISBN13(number)
+
+	return err == nil
+}
+
+// isbnEntryFromText reads an ISBN number with the text before and after it.
+func (b *Book) isbnEntryFromText(before, number, rest string) ISBN {
+	entry := ISBN{Raw: isbn.Normalize(number)}
+	if isbn13, err := isbn.ToISBN13(entry.Raw); err == nil {
+		entry.ISBN13 = isbn13
+		entry.ISBN10, _ = isbn.ToISBN10(entry.Raw)
+		entry.Valid = true
+	}
+
+	if ss := reISBNQual.FindStringSubmatch(rest); len(ss) > 1 {
+		entry.Qualifier = ss[1]
+		rest = strings.Replace(rest, ss[0], "", 1)
+	} else {
+		entry.Qualifier = before
+	}
+	entry.Qualifier = strings.Trim(util.Clean(entry.Qualifier), ":؛،, ")
+	if ss := reISBNVolume.FindStringSubmatch(entry.Qualifier); len(ss) > 1 {
+		entry.Volume, _ = persian.Atoi(ss[1])
+	}
+
+	if rest = strings.Trim(util.Clean(rest), ":؛،,. "); reNumber.MatchString(rest) {
+		entry.Price = priceFromText(rest)
+	}
+
+	return entry
+}
@@ -0,0 +1,84 @@
+package isbn
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownRange is returned by Hyphenate for ISBNs outside the
+// registration groups and ranges it knows.
+var ErrUnknownRange = errors.New("isbn: unknown registration range")
+
+// registrant is a range of registrant elements of a registration group.
+// lo and hi are the six digits following the group and a padding zero,
+// the form the ISBN Agency publishes ranges in.
+type registrant struct {
+	lo, hi int
+	length int
+}
+
+// groups has the registrant ranges of the Iranian registration groups,
+// keyed by prefix and group, as published by the International ISBN Agency.
+var groups = map[string][]registrant{
+	"978964": {
+		{0, 1499999, 2},
+		{1500000, 2499999, 3},
+		{2500000, 2999999, 4},
+		{3000000, 5499999, 3},
+		{5500000, 8999999, 4},
+		{9000000, 9699999, 5},
+		{9700000, 9899999, 3},
+		{9900000, 9999999, 4},
+	},
+	"978600": {
+		{0, 999999, 2},
+		{1000000, 4999999, 3},
+		{5000000, 8999999, 4},
+		{9000000, 9867999, 5},
+		{9868000, 9929999, 4},
+		{9930000, 9959999, 3},
+		{9960000, 9999999, 5},
+	},
+	"978622": {
+		{0, 1099999, 2},
+		{2000000, 4599999, 3},
+		{4900000, 8999999, 4},
+		{9250000, 9999999, 5},
+	},
+}
+
+// Hyphenate parses s and returns it hyphenated into its prefix,
+// registration group, registrant, publication and check digit, e.g.
+// "978-600-7937-64-8". ISBN-10s are returned as ISBN-10s. Only the Iranian
+// groups 964, 600 and 622 are known.
+func Hyphenate(s string) (string, error) {
+	n, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	n13, _ := ToISBN13(n)
+
+	for group, ranges := range groups {
+		if !strings.HasPrefix(n13, group) {
+			continue
+		}
+
+		rest := n13[len(group):12]
+		key, _ := strconv.Atoi(rest + "0")
+		for _, r := range ranges {
+			if key < r.lo || key > r.hi {
+				continue
+			}
+
+			parts := []string{group[:3], group[3:], rest[:r.length], rest[r.length:], n[len(n)-1:]}
+			if len(n) == 10 {
+				parts = parts[1:]
+			}
+			return strings.Join(parts, "-"), nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrUnknownRange, s)
+}
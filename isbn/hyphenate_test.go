@@ -0,0 +1,34 @@
+package isbn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestHyphenate(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+		err error
+	}{
+		{"9786007937648", "978-600-7937-64-8", nil},
+		{"9786002297839", "978-600-229-783-9", nil},
+		{"9786009041503", "978-600-90415-0-3", nil},
+		{"9789640469026", "978-964-04-6902-6", nil},
+		{"۹۶۴۳۱۱۳۲۶۴", "964-311-326-4", nil},
+		{"9786226052313", "978-622-6052-31-3", nil},
+		{"9780804429573", "", ErrUnknownRange},
+		{"9786221234561", "", ErrUnknownRange},
+		{"978600", "", ErrInvalidISBN},
+	}
+
+	for i, test := range tests {
+		s, err := Hyphenate(test.s)
+		if !errors.Is(err, test.err) {
+			t.Errorf("Test %d: Expected error %v, but got %v", i, test.err, err)
+		}
+		if s != test.exp {
+			t.Errorf("Test %d: Expected %q, but got %q", i, test.exp, s)
+		}
+	}
+}
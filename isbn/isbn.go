@@ -0,0 +1,136 @@
+// Package isbn normalizes, validates, converts and hyphenates ISBNs as they
+// appear in NLAI records and user input, with Persian or Arabic-Indic
+// digits, hyphens and spaces.
+package isbn
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+var (
+	// ErrInvalidISBN is returned for input that isn't a 10 or 13 digit ISBN
+	// with a valid check digit.
+	ErrInvalidISBN = errors.New("isbn: invalid ISBN")
+	// ErrNoISBN10 is returned by ToISBN10 for 979 ISBNs, which have no
+	// ISBN-10 form.
+	ErrNoISBN10 = errors.New("isbn: no ISBN-10 form")
+)
+
+// Normalize converts the digits of s to ASCII and removes hyphens and
+// spaces. It doesn't validate s.
+func Normalize(s string) string {
+	s = persian.Digits(s)
+	s = strings.Map(func(r rune) rune {
+		switch r {
+		case '-', ' ', '\u00a0', '\u200c', '\u200e', '\u200f', '\u2010', '\u2013':
+			return -1
+		case 'x':
+			return 'X'
+		}
+		return r
+	}, s)
+
+	return s
+}
+
+// Validate reports whether s is a valid ISBN-10 or ISBN-13 once
+// normalized. The returned error wraps ErrInvalidISBN.
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// Parse normalizes and validates s, returning it as a 10 or 13 digit
+// string as it was given.
+func Parse(s string) (string, error) {
+	n := Normalize(s)
+	if (len(n) == 10 && valid10(n)) || (len(n) == 13 && valid13(n)) {
+		return n, nil
+	}
+
+	return "", fmt.Errorf("%w: %q", ErrInvalidISBN, s)
+}
+
+// ToISBN13 parses s and returns it as an ISBN-13.
+func ToISBN13(s string) (string, error) {
+	n, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	if len(n) == 13 {
+		return n, nil
+	}
+
+	n = "978" + n[:9]
+	return n + string(checkDigit13(n)), nil
+}
+
+// ToISBN10 parses s and returns it as an ISBN-10.
+func ToISBN10(s string) (string, error) {
+	n, err := Parse(s)
+	if err != nil {
+		return "", err
+	}
+	if len(n) == 10 {
+		return n, nil
+	}
+	if !strings.HasPrefix(n, "978") {
+		return "", fmt.Errorf("%w: %q", ErrNoISBN10, s)
+	}
+
+	n = n[3:12]
+	return n + string(checkDigit10(n)), nil
+}
+
+func valid10(s string) bool {
+	for i, r := range s {
+		if (r < '0' || r > '9') && (r != 'X' || i != 9) {
+			return false
+		}
+	}
+
+	return checkDigit10(s[:9]) == s[9]
+}
+
+func valid13(s string) bool {
+	if !strings.HasPrefix(s, "978") && !strings.HasPrefix(s, "979") {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return checkDigit13(s[:12]) == s[12]
+}
+
+func checkDigit10(s string) byte {
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += (10 - i) * int(s[i]-'0')
+	}
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return 'X'
+	}
+
+	return byte('0' + check)
+}
+
+func checkDigit13(s string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		d := int(s[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
@@ -0,0 +1,58 @@
+package isbn
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		s   string
+		exp string
+		err error
+	}{
+		{"9789646235793", "9789646235793", nil},
+		{"978-964-6235-79-3", "9789646235793", nil},
+		{"۹۷۸-۶۰۰-۸۲۳۷-۶۳-۱", "9786008237631", nil},
+		{"٩٧٨ ٦٠٠ ٦٨٦٠ ١٥ ٢", "9786006860152", nil},
+		{"964-311-326-4", "9643113264", nil},
+		{"0-8044-2957-x", "080442957X", nil},
+		{"9789646235794", "", ErrInvalidISBN},
+		{"964311326", "", ErrInvalidISBN},
+		{"97896462357X3", "", ErrInvalidISBN},
+		{"", "", ErrInvalidISBN},
+	}
+
+	for i, test := range tests {
+		s, err := Parse(test.s)
+		if !errors.Is(err, test.err) {
+			t.Errorf("Test %d: Expected error %v, but got %v", i, test.err, err)
+		}
+		if s != test.exp {
+			t.Errorf("Test %d: Expected %q, but got %q", i, test.exp, s)
+		}
+	}
+}
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		s      string
+		isbn13 string
+		isbn10 string
+		err10  error
+	}{
+		{"964-311-326-4", "9789643113261", "9643113264", nil},
+		{"9789643113261", "9789643113261", "9643113264", nil},
+		{"9786007937648", "9786007937648", "600793764X", nil},
+		{"979-10-90636-07-1", "9791090636071", "", ErrNoISBN10},
+	}
+
+	for i, test := range tests {
+		if isbn13, err := ToISBN13(test.s); err != nil || isbn13 != test.isbn13 {
+			t.Errorf("Test %d: Expected ISBN-13 %s, but got %s (%v)", i, test.isbn13, isbn13, err)
+		}
+		if isbn10, err := ToISBN10(test.s); !errors.Is(err, test.err10) || isbn10 != test.isbn10 {
+			t.Errorf("Test %d: Expected ISBN-10 %s (%v), but got %s (%v)", i, test.isbn10, test.err10, isbn10, err)
+		}
+	}
+}
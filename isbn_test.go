@@ -0,0 +1,102 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestISBNEntriesFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  []ISBN
+	}{
+		{
+			"‏978-600-7937-64-8: ۷۵۰۰۰۰ ریال",
+			[]ISBN{{Raw: "9786007937648", ISBN13: "9786007937648", ISBN10: "600793764X", Valid: true, Price: Price{Raw: "۷۵۰۰۰۰ ریال", Amount: 750000, Currency: Rial}}},
+		},
+		{
+			"‏۹۶۴-۳۱۱-۳۲۶-۴: ۳۸۰۰۰ ریال",
+			[]ISBN{{Raw: "9643113264", ISBN13: "9789643113261", ISBN10: "9643113264", Valid: true, Price: Price{Raw: "۳۸۰۰۰ ریال", Amount: 38000, Currency: Rial}}},
+		},
+		{
+			"‏ج. ۱: ۹۷۸-۶۰۰-۵۴۴۹-۰۷-۵؛ ۳۵۰۰۰ ریال (ج. ۱ ، چاپ دوم)",
+			[]ISBN{{Raw: "9786005449075", ISBN13: "9786005449075", ISBN10: "6005449079", Valid: true, Qualifier: "ج. ۱ ، چاپ دوم", Volume: 1, Price: Price{Raw: "۳۵۰۰۰ ریال", Amount: 35000, Currency: Rial}}},
+		},
+		{
+			"‏دوره: ۹۷۸-۶۰۰-۹۰۴۱۵-۰-۰",
+			[]ISBN{{Raw: "9786009041500", Qualifier: "دوره"}},
+		},
+		{
+			"‏0-8044-2957-X",
+			[]ISBN{{Raw: "080442957X", ISBN13: "9780804429573", ISBN10: "080442957X", Valid: true}},
+		},
+		{
+			"‏979-10-90636-07-1",
+			[]ISBN{{Raw: "9791090636071", ISBN13: "9791090636071", Valid: true}},
+		},
+		{
+			"‏978-964-311-326-1 (دوره)؛ 978-964-311-327-8 (ج.۱)",
+			[]ISBN{
+				{Raw: "9789643113261", ISBN13: "9789643113261", ISBN10: "9643113264", Valid: true, Qualifier: "دوره"},
+				{Raw: "9789643113278", ISBN13: "9789643113278", ISBN10: "9643113272", Valid: true, Qualifier: "ج.۱", Volume: 1},
+			},
+		},
+		{
+			"‏ج. ۱: 978-964-311-327-8؛ ۳۵۰۰۰ ریال؛ ج. ۲: 978-964-311-328-5؛ ۴۰۰۰۰ ریال",
+			[]ISBN{
+				{Raw: "9789643113278", ISBN13: "9789643113278", ISBN10: "9643113272", Valid: true, Qualifier: "ج. ۱", Volume: 1, Price: Price{Raw: "۳۵۰۰۰ ریال", Amount: 35000, Currency: Rial}},
+				{Raw: "9789643113285", ISBN13: "9789643113285", ISBN10: "9643113280", Valid: true, Qualifier: "ج. ۲", Volume: 2, Price: Price{Raw: "۴۰۰۰۰ ریال", Amount: 40000, Currency: Rial}},
+			},
+		},
+		{
+			"‏978-600-7937-64-8: ۱۲۰۰۰۰۰۰۰ ریال",
+			[]ISBN{{Raw: "9786007937648", ISBN13: "9786007937648", ISBN10: "600793764X", Valid: true, Price: Price{Raw: "۱۲۰۰۰۰۰۰۰ ریال", Amount: 120000000, Currency: Rial}}},
+		},
+		{
+			"‏9786007937648: 1200000000",
+			[]ISBN{{Raw: "9786007937648", ISBN13: "9786007937648", ISBN10: "600793764X", Valid: true, Price: Price{Raw: "1200000000", Amount: 1200000000}}},
+		},
+		{"‏۳۸۰۰۰ ریال", []ISBN{}},
+		{"‏۱۲۰۰۰۰۰۰۰ ریال", []ISBN{}},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if isbns := b.isbnEntriesFromField(test.text); !reflect.DeepEqual(isbns, test.exp) {
+			t.Errorf("Test %d: Expected isbns %+v, but got %+v", i, test.exp, isbns)
+		}
+	}
+}
+
+func TestISBNs(t *testing.T) {
+	tests := []struct {
+		url     string
+		primary string
+		volumes []int
+	}{
+		{"http://opac.nlai.ir/opac-prod/bibliographic/2072242", "9786005449068", []int{0, 1, 2}},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5481844", "9786007937648", []int{0}},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if isbn := book.ISBN(); isbn != test.primary {
+			t.Errorf("Test %d: Expected primary isbn %s, but got %s", i, test.primary, isbn)
+		}
+		isbns := book.ISBNs()
+		if len(isbns) != len(test.volumes) {
+			t.Errorf("Test %d: Expected %d isbns, but got %d", i, len(test.volumes), len(isbns))
+			continue
+		}
+		for j, isbn := range isbns {
+			if isbn.Volume != test.volumes[j] {
+				t.Errorf("Test %d: Expected isbn %d for volume %d, but got %d", i, j, test.volumes[j], isbn.Volume)
+			}
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// languages are the languages books are commonly written in or translated
+// from, by ISO 639-1 code, with their Persian names and the nationality
+// adjectives of subject headings like "داستان‌های آمریکایی" in Persian and
+// English.
+var languages = []struct {
+	code    string
+	names   []string
+	english []string
+}{
+	{"fa", []string{"فارسی", "ایرانی"}, []string{"Persian", "Iranian"}},
+	{"en", []string{"انگلیسی", "آمریکایی", "امریکایی", "بریتانیایی", "ایرلندی", "اسکاتلندی", "کانادایی", "استرالیایی"},
+		[]string{"English", "American", "British", "Irish", "Scottish", "Canadian", "Australian"}},
+	{"fr", []string{"فرانسه", "فرانسوی"}, []string{"French"}},
+	{"de", []string{"آلمانی"}, []string{"German"}},
+	{"es", []string{"اسپانیایی", "اسپانیولی", "آمریکای لاتین"}, []string{"Spanish", "Latin American"}},
+	{"ar", []string{"عربی"}, []string{"Arabic"}},
+	{"ru", []string{"روسی"}, []string{"Russian"}},
+	{"it", []string{"ایتالیایی"}, []string{"Italian"}},
+	{"pt", []string{"پرتغالی", "برزیلی"}, []string{"Portuguese", "Brazilian"}},
+	{"sv", []string{"سوئدی"}, []string{"Swedish"}},
+	{"no", []string{"نروژی"}, []string{"Norwegian"}},
+	{"da", []string{"دانمارکی"}, []string{"Danish"}},
+	{"nl", []string{"هلندی"}, []string{"Dutch"}},
+	{"tr", []string{"ترکی", "ترکیه‌ای"}, []string{"Turkish"}},
+	{"ja", []string{"ژاپنی"}, []string{"Japanese"}},
+	{"zh", []string{"چینی"}, []string{"Chinese"}},
+	{"el", []string{"یونانی"}, []string{"Greek"}},
+	{"ur", []string{"اردو"}, []string{"Urdu"}},
+}
+
+// latinStopWords are common short words of the languages written in Latin
+// script, used to tell the language of an original title.
+var latinStopWords = []struct {
+	code  string
+	words []string
+}{
+	{"en", []string{"the", "of", "and", "a", "an", "in", "to", "for", "on", "with", "is"}},
+	{"fr", []string{"le", "la", "les", "l'", "et", "du", "des", "un", "une", "est", "au"}},
+	{"de", []string{"der", "die", "das", "und", "ein", "eine", "im", "mit", "von", "zu"}},
+	{"es", []string{"el", "los", "las", "y", "del", "al", "una", "con", "por"}},
+	{"it", []string{"il", "lo", "gli", "e", "della", "di", "che"}},
+	{"sv", []string{"ett", "och", "som", "att", "det", "är", "heter"}},
+}
+
+var (
+	reTranslatedFrom = regexp.MustCompile(`ترجمه از(?: زبان)?(?: اصلی)?\s+([^\s.،:؛]+(?: لاتین)?)`)
+	reLatinWord      = regexp.MustCompile(`[\p{L}]+'?`)
+)
+
+// Language returns the ISO 639-1 code of the language of the text, told by
+// a "زبان" note or a note naming just a language, like "عربی.", or else by
+// the script and letters of the title. It returns an empty string if it
+// can't tell.
+func (b *Book) Language() string {
+	for _, n := range b.Notes() {
+		if n.Type != NoteLanguage {
+			continue
+		}
+		if code := languageCode(noteLanguage(n)); code != "" {
+			return code
+		}
+	}
+
+	if name := b.Name(); isLatin(name) {
+		return latinLanguage(name)
+	}
+
+	return arabicScriptLanguage(b.fields.Get(LabelTitle))
+}
+
+// arabicScriptLanguage tells Persian from Arabic text by the characters
+// only one of them uses: "پ", "چ", "ژ", "گ", the zero width non-joiner and
+// the Persian forms of 4, 5 and 6 for Persian and "ة", "ى", "أ" and "إ"
+// for Arabic. It returns an empty string if s has neither.
+func arabicScriptLanguage(s string) string {
+	switch {
+	case strings.ContainsAny(s, "پچژگ\u200c۴۵۶"):
+		return "fa"
+	case strings.ContainsAny(s, "ةىأإ"):
+		return "ar"
+	}
+
+	return ""
+}
+
+// OriginalLanguage returns the ISO 639-1 code of the language a translated
+// book was translated from, or an empty string if the book is not known to
+// be a translation or the language can't be told. It reads the "زبان اصلی"
+// note, "ترجمه از" in the notes and title, the nationality of literary
+// subject headings and, last, the script and words of the original title.
+func (b *Book) OriginalLanguage() string {
+	notes := b.Notes()
+	for _, n := range notes {
+		if n.Type != NoteOriginalLanguage {
+			continue
+		}
+		if code := languageCode(noteLanguage(n)); code != "" {
+			return code
+		}
+	}
+
+	texts := []string{b.fields.Get(LabelTitle)}
+	for _, n := range notes {
+		texts = append(texts, n.Text)
+	}
+	for _, text := range texts {
+		ss := reTranslatedFrom.FindStringSubmatch(clean(text))
+		if ss == nil {
+			continue
+		}
+		if code := languageCode(ss[1]); code != "" {
+			return code
+		}
+	}
+
+	original := b.OriginalName()
+	if original == "" && len(b.Translators()) == 0 {
+		return ""
+	}
+
+	fa, en := b.Subjects()
+	for _, s := range append(fa, en...) {
+		if code := subjectLanguage(s.Heading); code != "" {
+			return code
+		}
+	}
+
+	return scriptLanguage(original)
+}
+
+// noteLanguage returns the language name of a language note.
+func noteLanguage(n Note) string {
+	if n.Value != "" {
+		return n.Value
+	}
+
+	return strings.Trim(n.Text, " .")
+}
+
+// languageCode returns the code of a Persian language name like "فرانسه"
+// or "اسپانیایی", or an empty string if it is not one.
+func languageCode(name string) string {
+	name = strings.NewReplacer("ي", "ی", "ك", "ک").Replace(strings.TrimSpace(name))
+	for _, l := range languages {
+		for _, n := range l.names {
+			if name == n {
+				return l.code
+			}
+		}
+	}
+
+	return ""
+}
+
+// literaryForms are the words that start subject headings of literature by
+// nationality, like "داستان‌های کوتاه ایرلندی" or "American fiction".
+var literaryForms = []string{
+	"داستان", "شعر", "نمایشنامه", "نامه", "ادبیات", "مقاله", "طنز",
+	"fiction", "stories", "poetry", "drama", "letters", "literature", "essays", "wit and humor",
+}
+
+// subjectLanguage returns the language of a literary subject heading, or an
+// empty string if the heading is not one or names no known nationality.
+func subjectLanguage(heading string) string {
+	heading = strings.NewReplacer("ي", "ی", "ك", "ک").Replace(heading)
+
+	literary := false
+	for _, form := range literaryForms {
+		if strings.Contains(strings.ToLower(heading), form) {
+			literary = true
+			break
+		}
+	}
+	if !literary {
+		return ""
+	}
+
+	for _, l := range languages {
+		for _, names := range [][]string{l.names, l.english} {
+			for _, name := range names {
+				if containsWord(heading, name) {
+					return l.code
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// containsWord reports whether s contains word with no letters around it.
+func containsWord(s, word string) bool {
+	for i := 0; ; {
+		j := strings.Index(s[i:], word)
+		if j < 0 {
+			return false
+		}
+		start, end := i+j, i+j+len(word)
+		before := []rune(s[:start])
+		after := []rune(s[end:])
+		if (len(before) == 0 || !isWordRune(before[len(before)-1])) &&
+			(len(after) == 0 || !isWordRune(after[0])) {
+			return true
+		}
+		i = end
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || r == '\u200c'
+}
+
+// scriptLanguage tells the language of a title by its script, and for
+// Latin script by its words.
+func scriptLanguage(s string) string {
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Arabic, r):
+			return "ar"
+		case unicode.Is(unicode.Cyrillic, r):
+			return "ru"
+		case unicode.Is(unicode.Greek, r):
+			return "el"
+		case unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r):
+			return "ja"
+		case unicode.Is(unicode.Han, r):
+			return "zh"
+		}
+	}
+	if isLatin(s) {
+		return latinLanguage(s)
+	}
+
+	return ""
+}
+
+// latinLanguage guesses the language of Latin text by its letters and most
+// common words, falling back to English.
+func latinLanguage(s string) string {
+	lower := strings.ToLower(s)
+	switch {
+	case strings.ContainsAny(lower, "ñ¿¡"):
+		return "es"
+	case strings.ContainsRune(lower, 'ß'):
+		return "de"
+	case strings.ContainsRune(lower, 'å'):
+		return "sv"
+	case strings.ContainsAny(lower, "éèêàçùœ"):
+		return "fr"
+	}
+
+	best, score := "en", 0
+	words := reLatinWord.FindAllString(lower, -1)
+	for _, sw := range latinStopWords {
+		n := 0
+		for _, w := range words {
+			for _, stop := range sw.words {
+				if w == stop {
+					n++
+				}
+			}
+		}
+		if n > score {
+			best, score = sw.code, n
+		}
+	}
+
+	return best
+}
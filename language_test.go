@@ -0,0 +1,110 @@
+package melli
+
+import "testing"
+
+func TestScriptLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  string
+	}{
+		{"A farewell to arms", "en"},
+		{"Pandora", "en"},
+		{"La nausée", "fr"},
+		{"Le lion et l'oiseau", "fr"},
+		{"Die Taube", "de"},
+		{"Carta al General Franco", "es"},
+		{"Cien años de soledad", "es"},
+		{"En man som heter Ove", "sv"},
+		{"Les vacances en mer, en montagne et en ville", "fr"},
+		{"Vivir en Madrid, en Sevilla y en Barcelona", "es"},
+		{"Il nome della rosa", "it"},
+		{"الأيام", "ar"},
+		{"Преступление и наказание", "ru"},
+		{"", ""},
+	}
+
+	for i, test := range tests {
+		if code := scriptLanguage(test.text); code != test.exp {
+			t.Errorf("Test %d: Expected language '%s' for '%s', but got '%s'", i, test.exp, test.text, code)
+		}
+	}
+}
+
+func TestArabicScriptLanguage(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  string
+	}{
+		{"سمفونی مردگان/ عباس معروفی.", "fa"},
+		{"کتاب\u200cهای درسی", "fa"},
+		{"فارنهایت ۴۵۱", "fa"},
+		{"منية المريد في أدب المفيد و المستفيد", "ar"},
+		{"الفتوحات المکیة", "ar"},
+		{"شدن/ میشل اوباما؛ ترجمه الهام رعایی.", ""},
+		{"", ""},
+	}
+
+	for i, test := range tests {
+		if code := arabicScriptLanguage(test.text); code != test.exp {
+			t.Errorf("Test %d: Expected language '%s' for '%s', but got '%s'", i, test.exp, test.text, code)
+		}
+	}
+}
+
+func TestSubjectLanguage(t *testing.T) {
+	tests := []struct {
+		heading string
+		exp     string
+	}{
+		{"داستان‌های آمریکایی", "en"},
+		{"داستان‌های کوتاه ایرلندی", "en"},
+		{"داستان‌های کودکان (آمریکایی)", "en"},
+		{"داستان‌های فرانسه", "fr"},
+		{"نامه‌های اسپانیایی", "es"},
+		{"داستان‌های آمریکای لاتین", "es"},
+		{"شعر فارسی", "fa"},
+		{"German fiction", "de"},
+		{"Short stories, Irish", "en"},
+		{"فلسفه فرانسوی", ""},
+		{"داستان‌های طنزآمیز", ""},
+	}
+
+	for i, test := range tests {
+		if code := subjectLanguage(test.heading); code != test.exp {
+			t.Errorf("Test %d: Expected language '%s' for '%s', but got '%s'", i, test.exp, test.heading, code)
+		}
+	}
+}
+
+func TestLanguage(t *testing.T) {
+	tests := []struct {
+		url      string
+		lang     string
+		original string
+	}{
+		{"http://opac.nlai.ir/opac-prod/bibliographic/636958", "fa", ""},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/3735689", "ar", ""},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/760159", "fa", "en"},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/4630184", "fa", "en"},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5481844", "", "en"},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/3125961", "fa", "de"},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/4315430", "fa", "fr"},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5171490", "fa", "es"},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5438339", "", "sv"},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if lang := book.Language(); lang != test.lang {
+			t.Errorf("Test %d: Expected language '%s', but got '%s'", i, test.lang, lang)
+		}
+		if original := book.OriginalLanguage(); original != test.original {
+			t.Errorf("Test %d: Expected original language '%s', but got '%s'", i, test.original, original)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package melli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// NoteType is the kind of a note (یادداشت), told by how the note starts.
+type NoteType int
+
+const (
+	NoteGeneral NoteType = iota
+	NoteOriginalTitle
+	NoteOriginalLanguage
+	NoteTranslation
+	NoteAgeGroup
+	NoteBibliography
+	NoteIndex
+	NotePreviousEdition
+	NoteEdition
+	NoteAlternativeTitle
+	NoteLanguage
+)
+
+var noteTypeNames = [...]string{
+	"general", "original_title", "original_language", "translation",
+	"age_group", "bibliography", "index", "previous_edition", "edition",
+	"alternative_title", "language",
+}
+
+func (t NoteType) String() string {
+	if t < 0 || int(t) >= len(noteTypeNames) {
+		return fmt.Sprintf("NoteType(%d)", int(t))
+	}
+
+	return noteTypeNames[t]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t NoteType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *NoteType) UnmarshalText(text []byte) error {
+	for i, name := range noteTypeNames {
+		if string(text) == name {
+			*t = NoteType(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("melli: unknown note type %q", text)
+}
+
+// Note is a note of a record. Text is the whole note as written and Value
+// what follows its "label:" prefix, if it has one.
+type Note struct {
+	Type  NoteType `json:"type"`
+	Text  string   `json:"text"`
+	Value string   `json:"value,omitempty"`
+}
+
+// notePrefixes are the labels notes of each type start with.
+var notePrefixes = []struct {
+	prefix string
+	typ    NoteType
+}{
+	{"عنوان اصلی", NoteOriginalTitle},
+	{"عنوان دیگر", NoteAlternativeTitle},
+	{"زبان اصلی", NoteOriginalLanguage},
+	{"زبان:", NoteLanguage},
+	{"گروه سنی", NoteAgeGroup},
+	{"کتابنامه", NoteBibliography},
+	{"نمایه", NoteIndex},
+	{"چاپ قبلی", NotePreviousEdition},
+}
+
+// Notes returns the notes of the record in record order.
+func (b *Book) Notes() []Note {
+	notes := make([]Note, 0)
+	for _, text := range b.fields.Values(LabelNote) {
+		if n := b.noteFromField(text); n.Text != "" {
+			notes = append(notes, n)
+		}
+	}
+
+	return notes
+}
+
+func (b *Book) noteFromField(text string) Note {
+	n := Note{Text: clean(text)}
+	key := strings.NewReplacer("ي", "ی", "ك", "ک", "\u200c", " ").Replace(n.Text)
+
+	for _, np := range notePrefixes {
+		if strings.HasPrefix(key, np.prefix) {
+			n.Type = np.typ
+			break
+		}
+	}
+
+	switch {
+	case n.Type != NoteGeneral:
+	case rePrinting.MatchString(key):
+		if _, ok := persian.Ordinal(strings.TrimSpace(rePrinting.FindStringSubmatch(key)[1])); ok {
+			n.Type = NoteEdition
+		}
+	case strings.Contains(key, "ترجمه") && (strings.HasPrefix(key, "کتاب حاضر") || strings.HasPrefix(key, "این کتاب")):
+		n.Type = NoteTranslation
+	case languageCode(strings.Trim(key, " .")) != "":
+		n.Type = NoteLanguage
+	}
+
+	if i := strings.Index(n.Text, ":"); i >= 0 && n.Type != NoteGeneral && n.Type != NoteTranslation {
+		n.Value = strings.Trim(n.Text[i+1:], " .")
+	}
+
+	return n
+}
+
+// notesOfType returns the values of the notes of type t.
+func (b *Book) notesOfType(t NoteType) []string {
+	values := make([]string, 0)
+	for _, n := range b.Notes() {
+		if n.Type == t && n.Value != "" {
+			values = append(values, n.Value)
+		}
+	}
+
+	return values
+}
+
+// AgeGroupNote returns the age group letters of the "گروه سنی" note, like
+// "الف، ب", or an empty string if there is none. See AgeGroups.
+func (b *Book) AgeGroupNote() string {
+	if values := b.notesOfType(NoteAgeGroup); len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// PreviousEditions returns the "چاپ قبلی" notes, which name the publisher
+// and year of earlier editions, like "گردون، ۱۳۶۸".
+func (b *Book) PreviousEditions() []string {
+	return b.notesOfType(NotePreviousEdition)
+}
+
+// AlternativeTitles returns the titles of the "عنوان دیگر" notes.
+func (b *Book) AlternativeTitles() []string {
+	return b.notesOfType(NoteAlternativeTitle)
+}
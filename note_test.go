@@ -0,0 +1,73 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNoteFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Note
+	}{
+		{"‏عنوان اصلی: A farewell to arms, 1929.", Note{NoteOriginalTitle, "عنوان اصلی: A farewell to arms, 1929.", "A farewell to arms, 1929"}},
+		{"‏عنوان دیگر: سه‌گانه‌ی نیویورک.", Note{NoteAlternativeTitle, "عنوان دیگر: سه‌گانه‌ی نیویورک.", "سه‌گانه‌ی نیویورک"}},
+		{"‏زبان اصلی: اسپانیایی.", Note{NoteOriginalLanguage, "زبان اصلی: اسپانیایی.", "اسپانیایی"}},
+		{"‏گروه سنی: الف، ب.", Note{NoteAgeGroup, "گروه سنی: الف، ب.", "الف، ب"}},
+		{"‏کتابنامه: ص. [۳۱۵] - ۳۲۰.", Note{NoteBibliography, "کتابنامه: ص. [۳۱۵] - ۳۲۰.", "ص. [۳۱۵] - ۳۲۰"}},
+		{"‏کتابنامه به صورت زیرنویس.", Note{NoteBibliography, "کتابنامه به صورت زیرنویس.", ""}},
+		{"‏نمایه.", Note{NoteIndex, "نمایه.", ""}},
+		{"‏چاپ قبلی: گردون، ۱۳۶۸.", Note{NotePreviousEdition, "چاپ قبلی: گردون، ۱۳۶۸.", "گردون، ۱۳۶۸"}},
+		{"‏چاپ یازدهم.", Note{NoteEdition, "چاپ یازدهم.", ""}},
+		{"‏کتاب حاضر در سال‌های مختلف توسط مترجمان و ناشران متفاوت ترجمه و منتشر شده است.",
+			Note{NoteTranslation, "کتاب حاضر در سال‌های مختلف توسط مترجمان و ناشران متفاوت ترجمه و منتشر شده است.", ""}},
+		{"‏ج. ۱ و ۲ (چاپ دوم: ۱۳۸۹).", Note{NoteGeneral, "ج. ۱ و ۲ (چاپ دوم: ۱۳۸۹).", ""}},
+		{"‏عربی.", Note{NoteLanguage, "عربی.", ""}},
+		{"‏زبان: انگلیسی.", Note{NoteLanguage, "زبان: انگلیسی.", "انگلیسی"}},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if n := b.noteFromField(test.text); n != test.exp {
+			t.Errorf("Test %d: Expected note %+v, but got %+v", i, test.exp, n)
+		}
+	}
+}
+
+func TestNotes(t *testing.T) {
+	tests := []struct {
+		url              string
+		ageGroup         string
+		previousEditions []string
+		types            []NoteType
+	}{
+		{"http://opac.nlai.ir/opac-prod/bibliographic/636958", "",
+			[]string{"گردون، ۱۳۶۸"}, []NoteType{NotePreviousEdition, NoteEdition}},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/2345835", "ب، ج",
+			[]string{}, []NoteType{NoteOriginalTitle, NoteAgeGroup}},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5481844", "",
+			[]string{}, []NoteType{NoteOriginalTitle, NoteTranslation, NoteIndex}},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if ageGroup := book.AgeGroupNote(); ageGroup != test.ageGroup {
+			t.Errorf("Test %d: Expected age group note '%s', but got '%s'", i, test.ageGroup, ageGroup)
+		}
+		if eds := book.PreviousEditions(); !reflect.DeepEqual(eds, test.previousEditions) {
+			t.Errorf("Test %d: Expected previous editions %q, but got %q", i, test.previousEditions, eds)
+		}
+		types := make([]NoteType, 0)
+		for _, n := range book.Notes() {
+			types = append(types, n.Type)
+		}
+		if !reflect.DeepEqual(types, test.types) {
+			t.Errorf("Test %d: Expected note types %v, but got %v", i, test.types, types)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package melli
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// Physical is the physical description (مشخصات ظاهری) of a book. Sizes are
+// in centimeters; zero means the record doesn't say.
+type Physical struct {
+	Raw         string  `json:"raw"`
+	Pages       int     `json:"pages"`
+	Volumes     int     `json:"volumes"`
+	Illustrated bool    `json:"illustrated"`
+	Colored     bool    `json:"colored"`
+	Tables      bool    `json:"tables"`
+	Charts      bool    `json:"charts"`
+	Height      float64 `json:"height"`
+	Width       float64 `json:"width"`
+}
+
+var (
+	rePages      = regexp.MustCompile(`((?:\[?\d+\]?\s*[،,]\s*)*\[?\d+\]?)\s*ص`)
+	reVolumes    = regexp.MustCompile(`(\d+)\s*ج\.(?:[^\p{L}]|$)`)
+	reDigitRuns  = regexp.MustCompile(`\d+`)
+	reDimensions = regexp.MustCompile(`(\d+(?:[/.]\d+)?)\s*(?:[×xX*]\s*(\d+(?:[/.]\d+)?))?\s*(?:س\s*\x{200c}?\s*م|cm)`)
+)
+
+func (b *Book) Physical() Physical {
+	if text := b.fields.Get(LabelPhysical); text != "" {
+		return b.physicalFromField(text)
+	}
+
+	return Physical{}
+}
+
+func (b *Book) physicalFromField(text string) Physical {
+	p := Physical{Raw: clean(text)}
+	text = persian.Digits(p.Raw)
+
+	if ss := reDimensions.FindStringSubmatch(text); len(ss) > 2 {
+		p.Height = parseCentimeters(ss[1])
+		p.Width = parseCentimeters(ss[2])
+		text = strings.Replace(text, ss[0], "", 1)
+	}
+
+	if ss := rePages.FindStringSubmatch(text); len(ss) > 1 {
+		for _, n := range reDigitRuns.FindAllString(ss[1], -1) {
+			pages, _ := strconv.Atoi(n)
+			p.Pages += pages
+		}
+	}
+	if ss := reVolumes.FindStringSubmatch(text); len(ss) > 1 {
+		p.Volumes, _ = strconv.Atoi(ss[1])
+	}
+
+	p.Illustrated = strings.Contains(text, "مصور")
+	p.Colored = strings.Contains(text, "رنگی")
+	p.Tables = strings.Contains(text, "جدول")
+	p.Charts = strings.Contains(text, "نمودار")
+
+	return p
+}
+
+// parseCentimeters parses a size written with either a Persian decimal slash
+// or a dot.
+func parseCentimeters(s string) float64 {
+	f, _ := strconv.ParseFloat(strings.ReplaceAll(s, "/", "."), 64)
+	return f
+}
@@ -0,0 +1,89 @@
+package melli
+
+import (
+	"testing"
+)
+
+func TestPhysicalFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Physical
+	}{
+		{
+			"‏۲۴۰ ص.: مصور (رنگی)؛ ۲۱/۵×۱۴/۵ س م.",
+			Physical{Pages: 240, Illustrated: true, Colored: true, Height: 21.5, Width: 14.5},
+		},
+		{
+			"‏۳۴۴ ص.؛ ۲۱/۵ س م.",
+			Physical{Pages: 344, Height: 21.5},
+		},
+		{
+			"‏۳۵۲ص.: جدول.؛ ۲۱/۵×۱۴/۵ س‌م.",
+			Physical{Pages: 352, Tables: true, Height: 21.5, Width: 14.5},
+		},
+		{
+			"‏۴۶۴ ص.: مصور، جدول، نمودار.",
+			Physical{Pages: 464, Illustrated: true, Tables: true, Charts: true},
+		},
+		{
+			"‏[۳۲] ص.: مصور (رنگی).؛ ۲۲×۲۹ س‌م.",
+			Physical{Pages: 32, Illustrated: true, Colored: true, Height: 22, Width: 29},
+		},
+		{
+			"‏۲۴۰، [۸] ص.؛ ۲۰ × ۱۳ س‌م.",
+			Physical{Pages: 248, Height: 20, Width: 13},
+		},
+		{
+			"‏۲ج. (۱۲۰۰ ص.)",
+			Physical{Pages: 1200, Volumes: 2},
+		},
+		{
+			"‏۱۰ ج. در ۵ مجلد (۲۸۳۶ ص.)",
+			Physical{Pages: 2836, Volumes: 10},
+		},
+		{
+			"‏ج.: مصور.",
+			Physical{Illustrated: true},
+		},
+		{
+			"‏۲۴۰ ص.: ۵ جدول.",
+			Physical{Pages: 240, Tables: true},
+		},
+		{
+			"‏۱۲ ص.: ۲ ج نقشه.",
+			Physical{Pages: 12},
+		},
+		{
+			"‏۳ ج.",
+			Physical{Volumes: 3},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		p := b.physicalFromField(test.text)
+		p.Raw = ""
+		if p != test.exp {
+			t.Errorf("Test %d: Expected physical %+v, but got %+v", i, test.exp, p)
+		}
+	}
+}
+
+func TestPhysical(t *testing.T) {
+	book, err := testBook("http://opac.nlai.ir/opac-prod/bibliographic/5481844")
+	if err != nil {
+		t.Fatalf("Error on creating book: %s", err)
+	}
+
+	exp := Physical{
+		Raw:         "۵۸۴ ص.: مصور (بخشی رنگی).؛ ۲۱/۵ × ۱۴/۵ س‌م.",
+		Pages:       584,
+		Illustrated: true,
+		Colored:     true,
+		Height:      21.5,
+		Width:       14.5,
+	}
+	if p := book.Physical(); p != exp {
+		t.Errorf("Expected physical %+v, but got %+v", exp, p)
+	}
+}
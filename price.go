@@ -0,0 +1,97 @@
+package melli
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// Currency is the currency a price is given in.
+type Currency int
+
+const (
+	UnknownCurrency Currency = iota
+	Rial
+	Toman
+)
+
+var currencyNames = [...]string{"unknown", "rial", "toman"}
+
+func (c Currency) String() string {
+	if c < 0 || int(c) >= len(currencyNames) {
+		return fmt.Sprintf("Currency(%d)", int(c))
+	}
+
+	return currencyNames[c]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Currency) MarshalText() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Currency) UnmarshalText(text []byte) error {
+	for i, name := range currencyNames {
+		if string(text) == name {
+			*c = Currency(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("melli: unknown currency %q", text)
+}
+
+// Price is a printed price (بها) as given after an ISBN.
+type Price struct {
+	Raw      string   `json:"raw,omitempty"`
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency"`
+}
+
+var rePrice = regexp.MustCompile(`(\d{1,3}(?:[,٬،]\d{3})+|\d+)\s*(ریال|ريال|تومان|ر\.?|ت\.?)?`)
+
+// Rials returns the amount in rials, converting tomans. Amounts in an
+// unknown currency are returned as is.
+func (p Price) Rials() int64 {
+	if p.Currency == Toman {
+		return p.Amount * 10
+	}
+
+	return p.Amount
+}
+
+// Price returns the price of the whole book or set: that of the first ISBN
+// with no qualifier or with "دوره". Prices given only for volumes are not
+// the price of the book; see ISBNs for them.
+func (b *Book) Price() Price {
+	for _, entry := range b.ISBNs() {
+		if entry.Price.Amount > 0 && (entry.Qualifier == "" || strings.HasPrefix(entry.Qualifier, "دوره")) {
+			return entry.Price
+		}
+	}
+
+	return Price{}
+}
+
+func priceFromText(text string) Price {
+	p := Price{Raw: text}
+	ss := rePrice.FindStringSubmatch(persian.Digits(text))
+	if len(ss) < 3 {
+		return p
+	}
+
+	amount := strings.NewReplacer(",", "", "٬", "", "،", "").Replace(ss[1])
+	p.Amount, _ = strconv.ParseInt(amount, 10, 64)
+	switch ss[2] {
+	case "ریال", "ريال", "ر", "ر.":
+		p.Currency = Rial
+	case "تومان", "ت", "ت.":
+		p.Currency = Toman
+	}
+
+	return p
+}
@@ -0,0 +1,79 @@
+package melli
+
+import "testing"
+
+func TestPriceFromText(t *testing.T) {
+	tests := []struct {
+		text     string
+		amount   int64
+		currency Currency
+		rials    int64
+	}{
+		{"۳۵۰۰۰ ریال", 35000, Rial, 35000},
+		{"۱۲۰,۰۰۰ ریال", 120000, Rial, 120000},
+		{"۱٬۲۰۰٬۰۰۰ریال", 1200000, Rial, 1200000},
+		{"٣٥٠٠٠ ريال", 35000, Rial, 35000},
+		{"۴۵۰۰ تومان", 4500, Toman, 45000},
+		{"۴۵۰۰ ت", 4500, Toman, 45000},
+		{"۳۵۰۰۰", 35000, UnknownCurrency, 35000},
+		{"رایگان", 0, UnknownCurrency, 0},
+	}
+
+	for i, test := range tests {
+		p := priceFromText(test.text)
+		if p.Amount != test.amount || p.Currency != test.currency {
+			t.Errorf("Test %d: Expected price %d %s, but got %d %s",
+				i, test.amount, test.currency, p.Amount, p.Currency)
+		}
+		if rials := p.Rials(); rials != test.rials {
+			t.Errorf("Test %d: Expected %d rials, but got %d", i, test.rials, rials)
+		}
+	}
+}
+
+func TestPrice(t *testing.T) {
+	tests := []struct {
+		url    string
+		exp    Price
+		volume []int64
+	}{
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/5481844",
+			Price{Raw: "۷۵۰۰۰۰ ریال", Amount: 750000, Currency: Rial},
+			[]int64{750000},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/2072242",
+			Price{},
+			[]int64{0, 35000, 40000},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/3388150",
+			Price{},
+			[]int64{0, 0, 0},
+		},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if p := book.Price(); p != test.exp {
+			t.Errorf("Test %d: Expected price %+v, but got %+v", i, test.exp, p)
+		}
+		isbns := book.ISBNs()
+		if len(isbns) != len(test.volume) {
+			t.Errorf("Test %d: Expected %d isbns, but got %d", i, len(test.volume), len(isbns))
+			continue
+		}
+		for j, isbn := range isbns {
+			if isbn.Price.Amount != test.volume[j] {
+				t.Errorf("Test %d: Expected price %d for isbn %d, but got %d",
+					i, test.volume[j], j, isbn.Price.Amount)
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ketabchi/melli/date"
+	"github.com/ketabchi/melli/internal/persian"
+	"github.com/ketabchi/util"
+)
+
+// Publication is the publication statement (مشخصات نشر) of a book with the
+// edition and printing numbers found in the edition statement and notes.
+// Year is in the Solar Hijri calendar; the Gregorian and lunar Hijri years
+// are set only when the record gives them.
+type Publication struct {
+	Raw           string   `json:"raw"`
+	Places        []string `json:"places"`
+	Publishers    []string `json:"publishers"`
+	Year          int      `json:"year,omitempty"`
+	GregorianYear int      `json:"gregorian_year,omitempty"`
+	LunarYear     int      `json:"lunar_year,omitempty"`
+	Edition       int      `json:"edition,omitempty"`
+	Printing      int      `json:"printing,omitempty"`
+}
+
+var (
+	reEdition  = regexp.MustCompile(`ویراست\s+([^\]\.،؛:()]+)`)
+	rePrinting = regexp.MustCompile(`^چاپ\s+([^\]\.،؛:()]+)`)
+	reUnknown  = regexp.MustCompile(`^بی[\s\x{200c}]?(?:نا|جا)`)
+)
+
+func (b *Book) Publication() Publication {
+	p := Publication{Places: []string{}, Publishers: []string{}}
+	if text := b.fields.Get(LabelPublication); text != "" {
+		p = b.publicationFromField(text)
+	}
+
+	if text := b.fields.Get(LabelEdition); text != "" {
+		p.Edition, p.Printing = b.editionFromField(text)
+	}
+	if p.Printing == 0 {
+		for _, text := range b.fields.Values(LabelNote) {
+			ss := rePrinting.FindStringSubmatch(clean(text))
+			if len(ss) < 2 {
+				continue
+			}
+			if n, ok := persian.Ordinal(strings.TrimSpace(ss[1])); ok {
+				p.Printing = n
+				break
+			}
+		}
+	}
+
+	return p
+}
+
+func (b *Book) publicationFromField(text string) Publication {
+	text = reCleanDoubleColon.ReplaceAllString(text, ":")
+	p := Publication{Raw: clean(text), Places: []string{}, Publishers: []string{}}
+
+	text, pubDate := splitPubDate(p.Raw)
+	p.Year, p.GregorianYear, p.LunarYear = b.pubYears(text, pubDate)
+
+	for _, statement := range strings.Split(text, "؛") {
+		place, publishers := "", statement
+		if i := strings.Index(statement, ":"); i >= 0 {
+			place, publishers = statement[:i], statement[i+1:]
+		} else {
+			place, publishers = statement, ""
+		}
+
+		if place = cleanImprint(place); place != "" {
+			p.Places = append(p.Places, place)
+		}
+		for _, publisher := range strings.Split(publishers, "،") {
+			publisher = cleanImprint(publisher)
+			publisher = strings.TrimPrefix(publisher, "نشر ")
+			publisher = strings.TrimPrefix(publisher, "انتشارات ")
+			if publisher != "" {
+				p.Publishers = append(p.Publishers, publisher)
+			}
+		}
+	}
+
+	return p
+}
+
+// splitPubDate splits a publication statement into the place and publisher
+// part and the date after its last comma, Persian or Latin.
+func splitPubDate(text string) (imprint, pubDate string) {
+	text = strings.ReplaceAll(text, "٬", "،")
+	i, sep := strings.LastIndex(text, "،"), "،"
+	if j := strings.LastIndex(text, ","); j > i {
+		i, sep = j, ","
+	}
+	if i >= 0 && reNumber.MatchString(text[i:]) {
+		return text[:i], text[i+len(sep):]
+	} else if !strings.Contains(text, ":") && reNumber.MatchString(text) {
+		return "", text
+	}
+
+	return text, ""
+}
+
+func cleanImprint(s string) string {
+	s = strings.Trim(util.Clean(s), "[]. ")
+	if reUnknown.MatchString(s) {
+		return ""
+	}
+
+	return s
+}
+
+// pubYears reads the years of a date like "۱۳۹۸", "[۱۳۹۸]",
+// "۱۴۳۵ق. = ۱۳۹۳" or "۱۳۹۸ [۲۰۱۹م.]" following imprint.
+func (b *Book) pubYears(imprint, pubDate string) (year, gregorian, lunar int) {
+	for _, y := range date.ParseImprintYears(imprint, pubDate) {
+		switch y.Calendar {
+		case date.Gregorian:
+			if gregorian == 0 {
+				gregorian = y.Value
+			}
+		case date.Hijri:
+			if lunar == 0 {
+				lunar = y.Value
+			}
+		default:
+			if year == 0 {
+				year = y.Value
+			}
+		}
+	}
+
+	return
+}
+
+// PublicationYear returns the year of publication with its calendar and
+// how certain the cataloger was of it. The Solar Hijri year is preferred
+// when the record gives years in more than one calendar.
+func (b *Book) PublicationYear() date.Year {
+	if text := b.fields.Get(LabelPublication); text != "" {
+		return b.publicationYearFromField(text)
+	}
+
+	return date.Year{}
+}
+
+func (b *Book) publicationYearFromField(text string) date.Year {
+	years := date.ParseImprintYears(splitPubDate(clean(text)))
+	for _, y := range years {
+		if y.Calendar == date.Jalali {
+			return y
+		}
+	}
+	if len(years) > 0 {
+		return years[0]
+	}
+
+	return date.Year{}
+}
+
+// editionFromField reads the edition and printing numbers of an edition
+// statement like "ویراست ۲" or "ویراست دوم، چاپ سوم".
+func (b *Book) editionFromField(text string) (edition, printing int) {
+	text = clean(text)
+	if ss := reEdition.FindStringSubmatch(text); len(ss) > 1 {
+		edition, _ = persian.Ordinal(strings.TrimSpace(ss[1]))
+	}
+	if i := strings.Index(text, "چاپ"); i >= 0 {
+		if ss := rePrinting.FindStringSubmatch(text[i:]); len(ss) > 1 {
+			printing, _ = persian.Ordinal(strings.TrimSpace(ss[1]))
+		}
+	}
+
+	return
+}
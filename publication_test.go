@@ -0,0 +1,127 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ketabchi/melli/date"
+)
+
+func TestPublicationFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Publication
+	}{
+		{
+			"‏تهران: ققنوس، ۱۳۸۶.",
+			Publication{Places: []string{"تهران"}, Publishers: []string{"ققنوس"}, Year: 1386},
+		},
+		{
+			"‏شیراز: شهر قلم؛ تهران: نشر چشمه، ۱۳۹۲.",
+			Publication{Places: []string{"شیراز", "تهران"}, Publishers: []string{"شهر قلم", "چشمه"}, Year: 1392},
+		},
+		{
+			"‏تهران: قدیانی، کتاب‌های بنفشه، ۱۳۹۶.",
+			Publication{Places: []string{"تهران"}, Publishers: []string{"قدیانی", "کتاب‌های بنفشه"}, Year: 1396},
+		},
+		{
+			"‏قم: دفتر تبلیغات اسلامی حوزه علمیه قم، ۱۴۳۵ق. = ۱۳۹۳.",
+			Publication{Places: []string{"قم"}, Publishers: []string{"دفتر تبلیغات اسلامی حوزه علمیه قم"}, Year: 1393, LunarYear: 1435},
+		},
+		{
+			"‏تهران: ققنوس، ۱۳۹۸ [۲۰۱۹م.]",
+			Publication{Places: []string{"تهران"}, Publishers: []string{"ققنوس"}, Year: 1398, GregorianYear: 2019},
+		},
+		{
+			"‏تهران:‏ : موسسه فرهنگی هنری شهرستان ادب، ۱۳۹۳.",
+			Publication{Places: []string{"تهران"}, Publishers: []string{"موسسه فرهنگی هنری شهرستان ادب"}, Year: 1393},
+		},
+		{
+			"‏تهران: حوض نقره، ۱۳۸۹-",
+			Publication{Places: []string{"تهران"}, Publishers: []string{"حوض نقره"}, Year: 1389},
+		},
+		{
+			"‏[بی‌جا]: [بی‌نا]، [۱۳۸۰]",
+			Publication{Places: []string{}, Publishers: []string{}, Year: 1380},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		p := b.publicationFromField(test.text)
+		p.Raw = ""
+		if !reflect.DeepEqual(p, test.exp) {
+			t.Errorf("Test %d: Expected publication %+v, but got %+v", i, test.exp, p)
+		}
+	}
+}
+
+func TestEditionFromField(t *testing.T) {
+	tests := []struct {
+		text     string
+		edition  int
+		printing int
+	}{
+		{"‏[ویراست ۲].", 2, 0},
+		{"‏ویراست دوم، چاپ سوم.", 2, 3},
+		{"‏ویراست بیست و یکم.", 21, 0},
+		{"‏چاپ ۴.", 0, 4},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if edition, printing := b.editionFromField(test.text); edition != test.edition || printing != test.printing {
+			t.Errorf("Test %d: Expected edition %d and printing %d, but got %d and %d",
+				i, test.edition, test.printing, edition, printing)
+		}
+	}
+}
+
+func TestPublication(t *testing.T) {
+	tests := []struct {
+		url      string
+		edition  int
+		printing int
+	}{
+		{"http://opac.nlai.ir/opac-prod/bibliographic/636958", 2, 11},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/1929190", 0, 15},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5481844", 0, 0},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if p := book.Publication(); p.Edition != test.edition || p.Printing != test.printing {
+			t.Errorf("Test %d: Expected edition %d and printing %d, but got %d and %d",
+				i, test.edition, test.printing, p.Edition, p.Printing)
+		}
+	}
+}
+
+func TestPublicationYearFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  date.Year
+	}{
+		{"‏تهران: ققنوس، ۱۳۸۶.", date.Year{Value: 1386, Calendar: date.Jalali}},
+		{"‏تهران: ققنوس، [۱۳۹۸؟]", date.Year{Value: 1398, Calendar: date.Jalali, Bracketed: true, Uncertain: true}},
+		{"‏تهران: حوض نقره، ۱۳۸۹-", date.Year{Value: 1389, Calendar: date.Jalali, Open: true}},
+		{"‏قم: دفتر تبلیغات اسلامی حوزه علمیه قم، ۱۴۳۵ق. = ۱۳۹۳.", date.Year{Value: 1393, Calendar: date.Jalali}},
+		{"‏بیروت: دار الفکر، ۱۴۳۰ق.", date.Year{Value: 1430, Calendar: date.Hijri}},
+		{"‏بیروت: دار الفکر، ۱۴۲۰.", date.Year{Value: 1420, Calendar: date.Hijri}},
+		{"‏تهران: ققنوس، ۱۴۰۶.", date.Year{Value: 1406, Calendar: date.Jalali}},
+		{"‏London: Penguin, 2019.", date.Year{Value: 2019, Calendar: date.Gregorian}},
+		{"‏تهران: ققنوس", date.Year{}},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if y := b.publicationYearFromField(test.text); y != test.exp {
+			t.Errorf("Test %d: Expected year %+v, but got %+v", i, test.exp, y)
+		}
+	}
+}
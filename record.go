@@ -0,0 +1,74 @@
+package melli
+
+import "github.com/ketabchi/melli/date"
+
+// Record holds every field parsed from a bibliographic record page.
+type Record struct {
+	URL                        string           `json:"url"`
+	RecordID                   string           `json:"record_id"`
+	NationalBibliographyNumber string           `json:"national_bibliography_number"`
+	Name                       string           `json:"name"`
+	Publisher                  string           `json:"publisher"`
+	Author                     string           `json:"author"`
+	AuthorEn                   string           `json:"author_en"`
+	OriginalName               string           `json:"original_name"`
+	Translators                []string         `json:"translators"`
+	ISBN                       string           `json:"isbn"`
+	Series                     []string         `json:"series"`
+	Subjects                   []Subject        `json:"subjects"`
+	SubjectsEn                 []Subject        `json:"subjects_en"`
+	Dewey                      Classification   `json:"dewey"`
+	LCC                        Classification   `json:"lcc"`
+	Physical                   Physical         `json:"physical"`
+	Publication                Publication      `json:"publication"`
+	PublicationYear            date.Year        `json:"publication_year"`
+	ISBNs                      []ISBN           `json:"isbns"`
+	Price                      Price            `json:"price"`
+	Contributors               []Contributor    `json:"contributors"`
+	AddedEntries               []AddedEntry     `json:"added_entries"`
+	Authors                    []Author         `json:"authors"`
+	Title                      Title            `json:"title"`
+	CatalogingStatus           CatalogingStatus `json:"cataloging_status"`
+	Notes                      []Note           `json:"notes"`
+	AgeGroups                  []AgeGroup       `json:"age_groups"`
+	Language                   string           `json:"language"`
+	OriginalLanguage           string           `json:"original_language"`
+}
+
+// Record returns all the parsed fields of the book.
+func (b *Book) Record() Record {
+	faName, enName := b.Author()
+	subjects, subjectsEn := b.Subjects()
+
+	return Record{
+		URL:                        b.url,
+		RecordID:                   b.RecordID(),
+		NationalBibliographyNumber: b.NationalBibliographyNumber(),
+		Name:                       b.Name(),
+		Publisher:                  b.Publisher(),
+		Author:                     faName,
+		AuthorEn:                   enName,
+		OriginalName:               b.OriginalName(),
+		Translators:                b.Translators(),
+		ISBN:                       b.ISBN(),
+		Series:                     b.Series(),
+		Subjects:                   subjects,
+		SubjectsEn:                 subjectsEn,
+		Dewey:                      b.Dewey(),
+		LCC:                        b.LCC(),
+		Physical:                   b.Physical(),
+		Publication:                b.Publication(),
+		PublicationYear:            b.PublicationYear(),
+		ISBNs:                      b.ISBNs(),
+		Price:                      b.Price(),
+		Contributors:               b.Contributors(),
+		AddedEntries:               b.AddedEntries(),
+		Authors:                    b.Authors(),
+		Title:                      b.Title(),
+		CatalogingStatus:           b.CatalogingStatus(),
+		Notes:                      b.Notes(),
+		AgeGroups:                  b.AgeGroups(),
+		Language:                   b.Language(),
+		OriginalLanguage:           b.OriginalLanguage(),
+	}
+}
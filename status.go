@@ -0,0 +1,84 @@
+package melli
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CatalogingStatus is how complete the cataloging (وضعیت فهرست نویسی) of a
+// book is. CIP (فیپا) records are made from the publisher's information
+// before the book is printed and are often incomplete or later corrected.
+type CatalogingStatus int
+
+const (
+	UnknownCatalogingStatus CatalogingStatus = iota
+	CatalogingCIP
+	CatalogingBriefCIP
+	CatalogingFull
+	CatalogingBrief
+	CatalogingPrevious
+	CatalogingOutsourced
+)
+
+var catalogingStatusNames = [...]string{"unknown", "cip", "brief_cip", "full", "brief", "previous", "outsourced"}
+
+func (s CatalogingStatus) String() string {
+	if s < 0 || int(s) >= len(catalogingStatusNames) {
+		return fmt.Sprintf("CatalogingStatus(%d)", int(s))
+	}
+
+	return catalogingStatusNames[s]
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CatalogingStatus) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CatalogingStatus) UnmarshalText(text []byte) error {
+	for i, name := range catalogingStatusNames {
+		if string(text) == name {
+			*s = CatalogingStatus(i)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("melli: unknown cataloging status %q", text)
+}
+
+// IsCIP reports whether s is one of the pre-publication statuses.
+func (s CatalogingStatus) IsCIP() bool {
+	return s == CatalogingCIP || s == CatalogingBriefCIP
+}
+
+// CatalogingStatus returns the cataloging status of the record. Use IsCIP
+// to tell records that may need fetching again once the book is published.
+func (b *Book) CatalogingStatus() CatalogingStatus {
+	if text := b.fields.Get(LabelCatalogingStatus); text != "" {
+		return b.catalogingStatusFromField(text)
+	}
+
+	return UnknownCatalogingStatus
+}
+
+func (b *Book) catalogingStatusFromField(text string) CatalogingStatus {
+	text = strings.NewReplacer(" ", "", "\u200c", "", "ي", "ی", "ك", "ک").Replace(clean(text))
+
+	switch {
+	case strings.Contains(text, "فیپا") && strings.Contains(text, "مختصر"):
+		return CatalogingBriefCIP
+	case strings.Contains(text, "فیپا"), strings.Contains(text, "پیشازانتشار"):
+		return CatalogingCIP
+	case strings.Contains(text, "برونسپاری"):
+		return CatalogingOutsourced
+	case strings.Contains(text, "قبلی"):
+		return CatalogingPrevious
+	case strings.Contains(text, "کامل"):
+		return CatalogingFull
+	case strings.Contains(text, "مختصر"):
+		return CatalogingBrief
+	}
+
+	return UnknownCatalogingStatus
+}
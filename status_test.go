@@ -0,0 +1,55 @@
+package melli
+
+import "testing"
+
+func TestCatalogingStatusFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  CatalogingStatus
+		cip  bool
+	}{
+		{"‏فیپا", CatalogingCIP, true},
+		{"‏فيپا", CatalogingCIP, true},
+		{"‏فیپای مختصر", CatalogingBriefCIP, true},
+		{"‏فهرستنویسی پیش از انتشار", CatalogingCIP, true},
+		{"‏فهرستنویسی کامل", CatalogingFull, false},
+		{"‏فهرست‌نویسی کامل", CatalogingFull, false},
+		{"‏فهرستنویسی قبلی", CatalogingPrevious, false},
+		{"‏فهرستنویسی مختصر", CatalogingBrief, false},
+		{"‏برون سپاری", CatalogingOutsourced, false},
+		{"‏", UnknownCatalogingStatus, false},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		s := b.catalogingStatusFromField(test.text)
+		if s != test.exp {
+			t.Errorf("Test %d: Expected cataloging status %s, but got %s", i, test.exp, s)
+		}
+		if s.IsCIP() != test.cip {
+			t.Errorf("Test %d: Expected IsCIP %v, but got %v", i, test.cip, s.IsCIP())
+		}
+	}
+}
+
+func TestCatalogingStatus(t *testing.T) {
+	tests := []struct {
+		url string
+		exp CatalogingStatus
+	}{
+		{"http://opac.nlai.ir/opac-prod/bibliographic/5481844", CatalogingCIP},
+		{"http://opac.nlai.ir/opac-prod/bibliographic/636958", CatalogingPrevious},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+		if s := book.CatalogingStatus(); s != test.exp {
+			t.Errorf("Test %d: Expected cataloging status %s, but got %s", i, test.exp, s)
+		}
+	}
+}
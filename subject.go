@@ -0,0 +1,140 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Subject is a subject heading (موضوع) split on its "--" subdivisions.
+type Subject struct {
+	Text          string   `json:"text"`
+	Heading       string   `json:"heading"`
+	Topical       []string `json:"topical,omitempty"`
+	Geographic    []string `json:"geographic,omitempty"`
+	Chronological []string `json:"chronological,omitempty"`
+	Form          []string `json:"form,omitempty"`
+}
+
+// String returns the heading with its subdivisions as written in the record.
+func (s Subject) String() string {
+	return s.Text
+}
+
+var (
+	geographicSubdivisions = []string{
+		"ایران", "تهران", "ایالات متحده", "آمریکا", "انگلستان", "بریتانیا",
+		"فرانسه", "آلمان", "ایتالیا", "اسپانیا", "روسیه", "شوروی", "چین",
+		"ژاپن", "هند", "افغانستان", "عراق", "ترکیه", "مصر", "کانادا",
+		"اروپا", "آسیا", "آفریقا", "خاورمیانه", "کشورهای اسلامی",
+		"Iran", "Tehran", "United States", "America", "England",
+		"Great Britain", "France", "Germany", "Italy", "Spain", "Russia",
+		"Soviet Union", "China", "Japan", "India", "Afghanistan", "Iraq",
+		"Turkey", "Egypt", "Canada", "Europe", "Asia", "Africa",
+		"Middle East", "Islamic countries",
+	}
+	formSubdivisions = []string{
+		"سرگذشتنامه", "خاطرات", "داستان", "شعر", "نمایشنامه", "نامه‌ها",
+		"واژه‌نامه‌ها", "دایره‌المعارف‌ها", "کتابشناسی", "نمایه‌ها",
+		"کتاب‌های درسی", "کتاب‌های کمک درسی", "راهنمای آموزشی",
+		"آزمون‌ها و تمرین‌ها", "مسائل، تمرین‌ها و غیره", "تصاویر",
+		"ادبیات کودکان", "ادبیات نوجوانان", "نشریات ادواری",
+		"دستنامه‌ها", "ترجمه شده به فارسی", "متون قدیمی تا قرن ۱۴",
+		"Biography", "Diaries", "Correspondence", "Fiction", "Juvenile fiction",
+		"Poetry", "Drama", "Dictionaries", "Encyclopedias", "Bibliography",
+		"Indexes", "Textbooks", "Study guides", "Examinations",
+		"Problems, exercises, etc.", "Pictorial works", "Juvenile literature",
+		"Periodicals", "Handbooks, manuals, etc.", "Translations into Persian",
+		"Early works to 1800",
+	}
+)
+
+func (b *Book) Subjects() (fa []Subject, en []Subject) {
+	fa, en = make([]Subject, 0), make([]Subject, 0)
+	for _, text := range b.fields.Values(LabelSubject) {
+		s := b.subjectFromField(text)
+		if s.Heading == "" {
+			continue
+		}
+		if isLatin(s.Heading) {
+			en = append(en, s)
+		} else {
+			fa = append(fa, s)
+		}
+	}
+
+	return
+}
+
+func (b *Book) subjectFromField(text string) Subject {
+	ss := strings.Split(text, "--")
+
+	s := Subject{Heading: cleanSubdivision(ss[0])}
+	subs := []string{s.Heading}
+	for _, sub := range ss[1:] {
+		sub = cleanSubdivision(sub)
+		if sub == "" {
+			continue
+		}
+		subs = append(subs, sub)
+
+		switch {
+		case containsFold(formSubdivisions, sub):
+			s.Form = append(s.Form, sub)
+		case containsFold(geographicSubdivisions, sub):
+			s.Geographic = append(s.Geographic, sub)
+		case isChronological(sub):
+			s.Chronological = append(s.Chronological, sub)
+		default:
+			s.Topical = append(s.Topical, sub)
+		}
+	}
+	s.Text = strings.Join(subs, " -- ")
+
+	return s
+}
+
+func cleanSubdivision(s string) string {
+	s = strings.ReplaceAll(s, "\n", " ")
+	s = clean(s)
+	if !strings.HasSuffix(s, "etc.") && !strings.HasSuffix(s, "م.") && !strings.HasSuffix(s, "ق.") {
+		s = strings.TrimSuffix(s, ".")
+	}
+
+	return strings.TrimSpace(s)
+}
+
+// eras are the periods named after "دوره" in chronological subdivisions,
+// like "دوره قاجار" or "دوره پیش از اسلام".
+var eras = []string{
+	"قاجار", "پهلوی", "صفوی", "افشار", "زند", "ساسانی", "اشکانی", "هخامنشی",
+	"سلجوقی", "غزنوی", "سامانی", "تیموری", "ایلخانی", "مغول", "اسلامی",
+	"باستان", "معاصر", "پیش از اسلام", "قبل از اسلام",
+}
+
+var (
+	reYearSubdivision = regexp.MustCompile(`^[0-9۰-۹]{3,4}\s*(?:[قمش]|ق\.م|ه\.ق|ه\.ش)?\.?$`)
+	reYearRange       = regexp.MustCompile(`(?:^|[\s،,])[0-9۰-۹]{3,4}\s*(?:[قمش]|ق\.م|ه\.ق|ه\.ش)?\.?\s*[-–]`)
+	rePeriod          = regexp.MustCompile(`^دوره\s+(?:[0-9۰-۹]|(?:` + strings.Join(eras, "|") + `)(?:ان|یان|یه)?(?:[^\p{L}\x{200c}]|$))`)
+)
+
+// isChronological reports whether s is a period: a century like "قرن ۱۴",
+// an era like "دوره قاجار", a year, or a range of years like "پهلوی، ۱۳۰۴ -
+// ۱۳۵۷". Other numbers, as in "ویندوز ۱۰", and other uses of "دوره", as in
+// "دوره‌های آموزشی", don't make a subdivision chronological.
+func isChronological(s string) bool {
+	if strings.HasPrefix(s, "قرن") || strings.Contains(strings.ToLower(s), "century") {
+		return true
+	}
+
+	return rePeriod.MatchString(s) || reYearSubdivision.MatchString(s) || reYearRange.MatchString(s)
+}
+
+func containsFold(ss []string, s string) bool {
+	for _, s1 := range ss {
+		if strings.EqualFold(s1, s) {
+			return true
+		}
+	}
+
+	return false
+}
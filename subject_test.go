@@ -0,0 +1,119 @@
+package melli
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSubjectFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Subject
+	}{
+		{
+			"‏داستان‌های فارسی -- قرن ۱۴",
+			Subject{Text: "داستان‌های فارسی -- قرن ۱۴", Heading: "داستان‌های فارسی", Chronological: []string{"قرن ۱۴"}},
+		},
+		{
+			"‏همسران رؤسای جمهور -- ایالات متحده -- سرگذشتنامه",
+			Subject{Text: "همسران رؤسای جمهور -- ایالات متحده -- سرگذشتنامه", Heading: "همسران رؤسای جمهور", Geographic: []string{"ایالات متحده"}, Form: []string{"سرگذشتنامه"}},
+		},
+		{
+			"‏Presidents' spouses -- United States -- Biography",
+			Subject{Text: "Presidents' spouses -- United States -- Biography", Heading: "Presidents' spouses", Geographic: []string{"United States"}, Form: []string{"Biography"}},
+		},
+		{
+			"‏روحانیت -- ایران -- جنبه‌های اجتماعی.",
+			Subject{Text: "روحانیت -- ایران -- جنبه‌های اجتماعی", Heading: "روحانیت", Topical: []string{"جنبه‌های اجتماعی"}, Geographic: []string{"ایران"}},
+		},
+		{
+			"‏Young adult fiction, American -- 21st century",
+			Subject{Text: "Young adult fiction, American -- 21st century", Heading: "Young adult fiction, American", Chronological: []string{"21st century"}},
+		},
+		{
+			"‏فلسفه فرانسوی -- قرن ۲۰م.",
+			Subject{Text: "فلسفه فرانسوی -- قرن ۲۰م.", Heading: "فلسفه فرانسوی", Chronological: []string{"قرن ۲۰م."}},
+		},
+		{
+			"‏انتخاب شغل",
+			Subject{Text: "انتخاب شغل", Heading: "انتخاب شغل"},
+		},
+		{
+			"‏ویندوز ۱۰ (سیستم عامل) -- ویندوز ۱۰",
+			Subject{Text: "ویندوز ۱۰ (سیستم عامل) -- ویندوز ۱۰", Heading: "ویندوز ۱۰ (سیستم عامل)", Topical: []string{"ویندوز ۱۰"}},
+		},
+		{
+			"‏ایران -- تاریخ -- پهلوی، ۱۳۰۴ - ۱۳۵۷",
+			Subject{Text: "ایران -- تاریخ -- پهلوی، ۱۳۰۴ - ۱۳۵۷", Heading: "ایران", Topical: []string{"تاریخ"}, Chronological: []string{"پهلوی، ۱۳۰۴ - ۱۳۵۷"}},
+		},
+		{
+			"‏World War, 1939-1945 -- Campaigns -- 1944",
+			Subject{Text: "World War, 1939-1945 -- Campaigns -- 1944", Heading: "World War, 1939-1945", Topical: []string{"Campaigns"}, Chronological: []string{"1944"}},
+		},
+		{
+			"‏حسابداری -- دوره‌های آموزشی",
+			Subject{Text: "حسابداری -- دوره‌های آموزشی", Heading: "حسابداری", Topical: []string{"دوره‌های آموزشی"}},
+		},
+		{
+			"‏ایران -- تاریخ -- دوره قاجار",
+			Subject{Text: "ایران -- تاریخ -- دوره قاجار", Heading: "ایران", Topical: []string{"تاریخ"}, Chronological: []string{"دوره قاجار"}},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if s := b.subjectFromField(test.text); !reflect.DeepEqual(s, test.exp) {
+			t.Errorf("Test %d: Expected subject %+v, but got %+v", i, test.exp, s)
+		}
+	}
+}
+
+func TestSubjects(t *testing.T) {
+	tests := []struct {
+		url string
+		fa  []string
+		en  []string
+	}{
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/5481844",
+			[]string{"اوباما، میشل، ۱۹۶۴ - م.", "همسران رؤسای جمهور -- ایالات متحده -- سرگذشتنامه"},
+			[]string{"Obama, Michelle", "Presidents' spouses -- United States -- Biography"},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/3399286",
+			[]string{"انتخاب شغل", "تیپ‌های شخصیتی"},
+			[]string{"Vocational guidance", "Typology (Psychology)"},
+		},
+		{
+			"http://opac.nlai.ir/opac-prod/bibliographic/5483716",
+			[]string{},
+			[]string{},
+		},
+	}
+
+	for i, test := range tests {
+		book, err := testBook(test.url)
+		if err != nil {
+			t.Errorf("Test %d: Error on creating book from %s: %s",
+				i, test.url, err)
+			continue
+		}
+
+		fa, en := book.Subjects()
+		if s := subjectStrings(fa); !reflect.DeepEqual(s, test.fa) {
+			t.Errorf("Test %d: Expected subjects %q, but got %q", i, test.fa, s)
+		}
+		if s := subjectStrings(en); !reflect.DeepEqual(s, test.en) {
+			t.Errorf("Test %d: Expected english subjects %q, but got %q", i, test.en, s)
+		}
+	}
+}
+
+func subjectStrings(subjects []Subject) []string {
+	ss := make([]string, 0)
+	for _, s := range subjects {
+		ss = append(ss, s.String())
+	}
+
+	return ss
+}
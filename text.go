@@ -0,0 +1,30 @@
+package melli
+
+import (
+	"strings"
+
+	"github.com/ketabchi/util"
+)
+
+// ltrMarks are the left-to-right marks and embeddings util.Clean leaves in
+// place. NLAI wraps Latin text and numbers in them.
+var ltrMarks = strings.NewReplacer("\u200e", "", "\u202a", "", "\u202d", "")
+
+func clean(s string) string {
+	return util.Clean(ltrMarks.Replace(s))
+}
+
+// isLatin reports whether s has Latin letters and no Arabic script ones.
+func isLatin(s string) bool {
+	latin := false
+	for _, r := range s {
+		switch {
+		case r >= 'A' && r <= 'Z', r >= 'a' && r <= 'z', r >= 0xc0 && r <= 0x24f:
+			latin = true
+		case r >= 0x600 && r <= 0x6ff, r >= 0xfb50 && r <= 0xfeff:
+			return false
+		}
+	}
+
+	return latin
+}
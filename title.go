@@ -0,0 +1,87 @@
+package melli
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ketabchi/melli/internal/persian"
+)
+
+// Title is the title proper of a book split into its parts, as in
+// "تاریخ ایران. ج. ۱، ایران باستان: از آغاز تا اسلام = History of Iran"
+// with Main "تاریخ ایران", Volume 1, PartName "ایران باستان", Subtitle
+// "از آغاز تا اسلام" and ParallelTitle "History of Iran".
+type Title struct {
+	Main          string `json:"main"`
+	Subtitle      string `json:"subtitle,omitempty"`
+	ParallelTitle string `json:"parallel_title,omitempty"`
+	Volume        int    `json:"volume,omitempty"`
+	PartName      string `json:"part_name,omitempty"`
+}
+
+var (
+	reTitleVolume = regexp.MustCompile(`(?:^|[.،:]?\s)(جلد|دفتر|کتاب|بخش|ج\s*\.)\s*\.?\s*([0-9۰-۹]+|[^\s.،:]+(?: و [^\s.،:]+)?)`)
+	reTitleGMD    = regexp.MustCompile(`\[(?:کتاب|Book)\]`)
+)
+
+// Title returns the parts of the title proper. Unlike Name, it reads the
+// title up to the last "/", as titles sometimes contain one.
+func (b *Book) Title() Title {
+	if text := b.fields.Get(LabelTitle); text != "" {
+		return b.titleFromField(text)
+	}
+
+	return Title{}
+}
+
+func (b *Book) titleFromField(text string) Title {
+	if i := strings.LastIndex(text, "/"); i >= 0 {
+		text = text[:i]
+	}
+	text = clean(reTitleGMD.ReplaceAllString(text, ""))
+
+	t := Title{}
+	if i := strings.Index(text, "="); i >= 0 {
+		t.ParallelTitle = trimTitle(text[i+1:])
+		text = text[:i]
+	}
+
+	for _, loc := range reTitleVolume.FindAllStringSubmatchIndex(text, -1) {
+		marker, number := text[loc[2]:loc[3]], text[loc[4]:loc[5]]
+		n, ok := persian.Ordinal(number)
+		if !ok || loc[0] == 0 {
+			continue
+		}
+		// "کتاب ۱۰۰ داستان" is a title; only ordinal words make "کتاب"
+		// and "بخش" mark a volume, as in "کتاب دوم".
+		if (marker == "کتاب" || marker == "بخش") && reNumber.MatchString(number) {
+			continue
+		}
+
+		t.Volume = n
+		part := strings.TrimLeft(text[loc[1]:], "،,:. ")
+		text = text[:loc[0]]
+		if i := strings.Index(part, ":"); i >= 0 {
+			t.Subtitle = trimTitle(part[i+1:])
+			part = part[:i]
+		}
+		t.PartName = trimTitle(part)
+		break
+	}
+
+	if i := strings.Index(text, ":"); i >= 0 {
+		if subtitle := trimTitle(text[i+1:]); t.Subtitle == "" {
+			t.Subtitle = subtitle
+		} else {
+			t.Subtitle = subtitle + ": " + t.Subtitle
+		}
+		text = text[:i]
+	}
+	t.Main = trimTitle(text)
+
+	return t
+}
+
+func trimTitle(s string) string {
+	return strings.TrimRight(strings.TrimLeft(strings.TrimSpace(s), ".،:; "), "،:; ")
+}
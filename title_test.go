@@ -0,0 +1,70 @@
+package melli
+
+import "testing"
+
+func TestTitleFromField(t *testing.T) {
+	tests := []struct {
+		text string
+		exp  Title
+	}{
+		{
+			"‏سمفونی مردگان/ عباس معروفی.",
+			Title{Main: "سمفونی مردگان"},
+		},
+		{
+			"‏شغل مناسب شما: با توجه به ویژگی‌های شخصیتی خود کارتان را انتخاب کنید .../ نویسندگان پل دی. تیگر، باربارا بارون - تیگر؛ مترجم حسن ملک.",
+			Title{Main: "شغل مناسب شما", Subtitle: "با توجه به ویژگی‌های شخصیتی خود کارتان را انتخاب کنید..."},
+		},
+		{
+			"‏دریدا و فلسفه [کتاب]/ گردآوری و ترجمه مهدی پارسا.",
+			Title{Main: "دریدا و فلسفه"},
+		},
+		{
+			"‏مدیریت اجرایی (For Dummies (MBA/ نویسنده پیتر اکونومی؛ ترجمه آرزو احمدی.",
+			Title{Main: "مدیریت اجرایی (For Dummies (MBA"},
+		},
+		{
+			"‏تاریخ ایران. ج. ۱، ایران باستان: از آغاز تا اسلام = History of Iran/ نوشته حسن پیرنیا.",
+			Title{Main: "تاریخ ایران", Volume: 1, PartName: "ایران باستان", Subtitle: "از آغاز تا اسلام", ParallelTitle: "History of Iran"},
+		},
+		{
+			"‏شاهنامه. دفتر یکم/ ابوالقاسم فردوسی.",
+			Title{Main: "شاهنامه", Volume: 1},
+		},
+		{
+			"‏خاطرات یک بچه چلمن: روزهای سگی جلد ۴/ نویسنده جف کینی.",
+			Title{Main: "خاطرات یک بچه چلمن", Subtitle: "روزهای سگی", Volume: 4},
+		},
+		{
+			"‏هزار و یک شب: کتاب ۱۰۰ داستان/ علی رضایی.",
+			Title{Main: "هزار و یک شب", Subtitle: "کتاب ۱۰۰ داستان"},
+		},
+		{
+			"‏تاریخ جهان: بخش ۲ جنگ‌ها/ علی رضایی.",
+			Title{Main: "تاریخ جهان", Subtitle: "بخش ۲ جنگ‌ها"},
+		},
+		{
+			"‏هری پاتر. کتاب دوم، هری پاتر و حفره اسرارآمیز/ جی. کی. رولینگ.",
+			Title{Main: "هری پاتر", Volume: 2, PartName: "هری پاتر و حفره اسرارآمیز"},
+		},
+		{
+			"‏مثنوی معنوی. ج.۳/ مولوی.",
+			Title{Main: "مثنوی معنوی", Volume: 3},
+		},
+		{
+			"‏کتاب عکس/ علی رضایی.",
+			Title{Main: "کتاب عکس"},
+		},
+		{
+			"‏کتاب کار ریاضی/ علی رضایی.",
+			Title{Main: "کتاب کار ریاضی"},
+		},
+	}
+
+	b := &Book{}
+	for i, test := range tests {
+		if title := b.titleFromField(test.text); title != test.exp {
+			t.Errorf("Test %d: Expected title %+v, but got %+v", i, test.exp, title)
+		}
+	}
+}